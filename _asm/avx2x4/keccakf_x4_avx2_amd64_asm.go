@@ -0,0 +1,113 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_x4_avx2_amd64.s, which applies
+// Keccak-f[1600] to four interleaved states at once with AVX2. Run it from
+// its directory with
+//
+//	go run . -out ../../keccakf_x4_avx2_amd64.s
+//
+// Each 256-bit register holds the same lane of the four states. The rounds
+// are organized as in the BMI2 implementation: each round reads the state
+// from one buffer and writes it to another, the caller's state and a buffer
+// on the stack taking turns, and the five lanes of a row are gathered in
+// registers. AVX2 has no vector rotate, so each rotation takes two shifts
+// and an OR, but the three-operand forms avoid any copies.
+package main
+
+import (
+	"os"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: DI points to the caller's state and SP to the
+// temporary one. The column parities of θ live in the row registers until
+// the θ effects are computed. The round constant is broadcast to rc.
+var (
+	row   = [5]VecPhysical{Y0, Y1, Y2, Y3, Y4}
+	theta = [5]VecPhysical{Y5, Y6, Y7, Y8, Y9}
+	tmp   = Y10
+	rc    = Y11
+)
+
+func main() {
+	// https://github.com/mmcloughlin/avo/issues/450
+	os.Setenv("GOOS", "linux")
+	os.Setenv("GOARCH", "amd64")
+
+	ConstraintExpr("amd64,!purego,!noasm,gc")
+	keccakF1600x4AVX2()
+	Generate()
+}
+
+// rotate sets dst to src rotated left by n bits, using tmp.
+func rotate(dst, src VecPhysical, n int) {
+	VPSLLQ(U8(n), src, tmp)
+	VPSRLQ(U8(64-n), src, dst)
+	VPOR(tmp, dst, dst)
+}
+
+func keccakF1600x4AVX2() {
+	TEXT("keccakF1600x4AVX2", 0, "func(a *[25][4]uint64)")
+	AllocLocal(800)
+	Load(Param("a"), RDI)
+
+	// The round constants are those of the Go code, in keccakf.go.
+	rcTable := Symbol{Name: "·rc"}
+
+	for round := 0; round < 24; round++ {
+		src, dst := Mem{Base: RDI}, Mem{Base: RSP}
+		if round%2 == 1 {
+			src, dst = dst, src
+		}
+		Commentf("Round %d", round)
+
+		// θ
+		for x := 0; x < 5; x++ {
+			VMOVDQU(src.Offset(32*x), row[x])
+			for y := 5; y < 25; y += 5 {
+				VPXOR(src.Offset(32*(x+y)), row[x], row[x])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			rotate(theta[x], row[(x+1)%5], 1)
+			VPXOR(row[(x+4)%5], theta[x], theta[x])
+		}
+
+		// ρ and π gather each output row, and χ combines it.
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+				sx := (x + 3*y) % 5
+				i := sx + 5*x
+				VPXOR(src.Offset(32*i), theta[sx], row[x])
+				if rho[i] != 0 {
+					rotate(row[x], row[x], rho[i])
+				}
+			}
+			for x := 0; x < 5; x++ {
+				VPANDN(row[(x+2)%5], row[(x+1)%5], tmp)
+				VPXOR(row[x], tmp, tmp)
+				if x == 0 && y == 0 {
+					VPBROADCASTQ(NewDataAddr(rcTable, 8*round), rc)
+					VPXOR(rc, tmp, tmp)
+				}
+				VMOVDQU(tmp, dst.Offset(32*(x+5*y)))
+			}
+		}
+	}
+	VZEROUPPER()
+	RET()
+}
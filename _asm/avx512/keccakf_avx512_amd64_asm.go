@@ -0,0 +1,176 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_avx512_amd64.s, the AVX-512 implementation
+// of Keccak-f[1600], and keccakf_x8_avx512_amd64.s, which applies it to
+// eight interleaved states at once. Run it from its directory with
+//
+//	go run . -out ../../keccakf_avx512_amd64.s
+//	go run . -lanes 8 -out ../../keccakf_x8_avx512_amd64.s
+//
+// The 25 lanes of the state are kept in registers X0 to X24 for the whole
+// permutation, which AVX-512 makes possible with its 32 vector registers.
+// Only the low 64 bits of each register are meaningful, unless the state is
+// made of eight interleaved ones, which then fill the 512-bit registers Z0
+// to Z24. θ and χ map to
+// VPTERNLOGQ, which computes any function of three inputs, and ρ to VPROLQ.
+// π only renames registers, so the 24 rounds are fully unrolled with the
+// mapping from lanes to registers tracked by the generator.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+var rc = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+var (
+	xmm = [32]VecPhysical{
+		X0, X1, X2, X3, X4, X5, X6, X7, X8, X9, X10, X11, X12, X13, X14, X15,
+		X16, X17, X18, X19, X20, X21, X22, X23, X24, X25, X26, X27, X28, X29, X30, X31,
+	}
+	zmm = [32]VecPhysical{
+		Z0, Z1, Z2, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z10, Z11, Z12, Z13, Z14, Z15,
+		Z16, Z17, Z18, Z19, Z20, Z21, Z22, Z23, Z24, Z25, Z26, Z27, Z28, Z29, Z30, Z31,
+	}
+)
+
+// Truth tables for VPTERNLOGQ, whose first input is the destination.
+const (
+	xor3    = 0x96 // a ^ b ^ c
+	xorAndn = 0xd2 // a ^ (^b & c)
+)
+
+func main() {
+	// https://github.com/mmcloughlin/avo/issues/450
+	os.Setenv("GOOS", "linux")
+	os.Setenv("GOARCH", "amd64")
+
+	lanes := flag.Int("lanes", 1, "number of interleaved states, 1 or 8")
+	flag.Parse()
+	if *lanes != 1 && *lanes != 8 {
+		log.Fatalf("unsupported number of states %d", *lanes)
+	}
+
+	ConstraintExpr("amd64,!purego,!noasm,gc")
+	keccakF1600AVX512(*lanes)
+	Generate()
+}
+
+func keccakF1600AVX512(lanes int) {
+	regs := xmm
+	if lanes == 8 {
+		regs = zmm
+	}
+	// Registers 25 to 29 hold the column parities of θ, and 30 and 31 are
+	// scratch registers.
+	parity := regs[25:30]
+	tmp0, tmp1 := regs[30], regs[31]
+
+	if lanes == 1 {
+		TEXT("keccakF1600AVX512", NOSPLIT, "func(a *[25]uint64)")
+	} else {
+		TEXT("keccakF1600x8AVX512", NOSPLIT, "func(a *[25][8]uint64)")
+	}
+	a := Mem{Base: RDI}
+	Load(Param("a"), a.Base)
+
+	// reg[i] is the register holding lane i.
+	var reg [25]VecPhysical
+	copy(reg[:], regs[:25])
+
+	for i := range reg {
+		if lanes == 1 {
+			VMOVQ(a.Offset(8*i), reg[i])
+		} else {
+			VMOVDQU64(a.Offset(64*i), reg[i])
+		}
+	}
+
+	// The round constants, broadcast to all the states for ι.
+	table := GLOBL("avx512RC", RODATA|NOPTR)
+	for i, c := range rc {
+		DATA(8*i, U64(c))
+	}
+
+	for round := range rc {
+		Commentf("Round %d", round)
+
+		// θ: compute the column parities, then fold the parities of the
+		// neighboring columns into every lane.
+		for x := 0; x < 5; x++ {
+			VMOVDQA64(reg[x], parity[x])
+			VPTERNLOGQ(U8(xor3), reg[x+10], reg[x+5], parity[x])
+			VPTERNLOGQ(U8(xor3), reg[x+20], reg[x+15], parity[x])
+		}
+		for x := 0; x < 5; x++ {
+			VPROLQ(U8(1), parity[(x+1)%5], tmp0)
+			for y := 0; y < 25; y += 5 {
+				VPTERNLOGQ(U8(xor3), tmp0, parity[(x+4)%5], reg[x+y])
+			}
+		}
+
+		// ρ and π: rotate every lane in place, then move it to its new
+		// position by renaming.
+		var next [25]VecPhysical
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				i := x + 5*y
+				if rho[i] != 0 {
+					VPROLQ(U8(rho[i]), reg[i], reg[i])
+				}
+				next[y+5*((2*x+3*y)%5)] = reg[i]
+			}
+		}
+		reg = next
+
+		// χ: each row is updated in place, saving the first two lanes
+		// for the last two.
+		for y := 0; y < 25; y += 5 {
+			b := reg[y : y+5]
+			VMOVDQA64(b[0], tmp0)
+			VMOVDQA64(b[1], tmp1)
+			VPTERNLOGQ(U8(xorAndn), b[2], b[1], b[0])
+			VPTERNLOGQ(U8(xorAndn), b[3], b[2], b[1])
+			VPTERNLOGQ(U8(xorAndn), b[4], b[3], b[2])
+			VPTERNLOGQ(U8(xorAndn), tmp0, b[4], b[3])
+			VPTERNLOGQ(U8(xorAndn), tmp1, tmp0, b[4])
+		}
+
+		// ι
+		VPXORQ_BCST(table.Offset(8*round), reg[0], reg[0])
+	}
+
+	Comment("Store the state")
+	for i := range reg {
+		if lanes == 1 {
+			VMOVQ(reg[i], a.Offset(8*i))
+		} else {
+			VMOVDQU64(reg[i], a.Offset(64*i))
+		}
+	}
+	VZEROUPPER()
+	RET()
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_bmi2_amd64.s, the scalar implementation of
+// Keccak-f[1600] for CPUs with the BMI1 and BMI2 extensions. Run it from its
+// directory with
+//
+//	go run . -out ../../keccakf_bmi2_amd64.s
+//
+// Each round reads the state from one buffer and writes it to another, the
+// caller's state and a buffer on the stack taking turns. The five lanes of
+// a row are gathered in registers, which folds π into the addressing, and
+// RORX and ANDN, which do not overwrite their source, let ρ and χ work on
+// them without extra copies.
+package main
+
+import (
+	"os"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: DI points to the caller's state and SP to the
+// temporary one. The column parities of θ live in the row registers until
+// the θ effects are computed.
+var (
+	theta = [5]GPPhysical{RAX, RBX, RCX, RDX, RSI}
+	row   = [5]GPPhysical{R8, R9, R10, R11, R12}
+	tmp   = R13
+)
+
+func main() {
+	// https://github.com/mmcloughlin/avo/issues/450
+	os.Setenv("GOOS", "linux")
+	os.Setenv("GOARCH", "amd64")
+
+	ConstraintExpr("amd64,!purego,!noasm,gc")
+	keccakF1600BMI2()
+	Generate()
+}
+
+func keccakF1600BMI2() {
+	TEXT("keccakF1600BMI2", 0, "func(a *[25]uint64)")
+	AllocLocal(200)
+	Load(Param("a"), RDI)
+
+	// The round constants are those of the Go code, in keccakf.go.
+	rc := Symbol{Name: "·rc"}
+
+	for round := 0; round < 24; round++ {
+		src, dst := Mem{Base: RDI}, Mem{Base: RSP}
+		if round%2 == 1 {
+			src, dst = dst, src
+		}
+		Commentf("Round %d", round)
+
+		// θ: the column parities go in the row registers, and the value
+		// to fold into column x in theta[x].
+		for x := 0; x < 5; x++ {
+			MOVQ(src.Offset(8*x), row[x])
+			for y := 5; y < 25; y += 5 {
+				XORQ(src.Offset(8*(x+y)), row[x])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			RORXQ(Imm(63), row[(x+1)%5], theta[x])
+			XORQ(row[(x+4)%5], theta[x])
+		}
+
+		// ρ and π gather each output row, and χ combines it.
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+				sx := (x + 3*y) % 5
+				i := sx + 5*x
+				MOVQ(src.Offset(8*i), row[x])
+				XORQ(theta[sx], row[x])
+				if rho[i] != 0 {
+					RORXQ(Imm(uint64(64-rho[i])), row[x], row[x])
+				}
+			}
+			for x := 0; x < 5; x++ {
+				ANDNQ(row[(x+2)%5], row[(x+1)%5], tmp)
+				XORQ(row[x], tmp)
+				if x == 0 && y == 0 {
+					XORQ(NewDataAddr(rc, 8*round), tmp)
+				}
+				MOVQ(tmp, dst.Offset(8*(x+5*y)))
+			}
+		}
+	}
+	RET()
+}
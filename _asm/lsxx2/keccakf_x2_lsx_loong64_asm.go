@@ -0,0 +1,149 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_x2_lsx_loong64.s, which applies
+// Keccak-f[1600] to two interleaved states at once with the 128-bit vector
+// instructions of LSX. Run it from its directory with
+//
+//	go run . -out ../../keccakf_x2_lsx_loong64.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format.
+//
+// Each of the 32 vector registers V0 to V31 holds two doublewords, and
+// V0 to V24 hold the same lane of both states for the whole permutation.
+// VROTRV rotates each doubleword by an immediate, so a rotation is a single
+// instruction, and VANDNV computes χ. The rounds are in a loop, as in the
+// VSX implementation for ppc64le: ρ and π move the lanes around the cycle
+// of π, so that each round leaves them where the next one expects them.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: V0 to V24 hold the lanes and V25 to V31 are
+// temporaries. R4 points to the state, R5 to the next round constant, and
+// R6 counts the rounds.
+const (
+	c0    = 25 // C[0] to C[4] in V25 to V29
+	work  = 30 // each D[x] in turn
+	saved = 31 // lane 1 during ρ and π
+	chiT  = 25 // the five terms of χ in V25 to V29
+	rcV   = 25 // the round constant
+)
+
+func main() {
+	out := flag.String("out", "keccakf_x2_lsx_loong64.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(w *bufio.Writer, out string) {
+	emit := func(format string, args ...any) {
+		fmt.Fprintf(w, "\t"+format+"\n", args...)
+	}
+	// rotate sets Vd to Vs rotated left by n bits, 0 < n < 64.
+	rotate := func(d, s, n int) {
+		emit("VROTRV $%d, V%d, V%d", 64-n, s, d)
+	}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_x2_lsx_loong64_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build loong64 && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "#include \"textflag.h\"")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func keccakF1600x2LSX(a *[25][2]uint64)")
+	fmt.Fprintln(w, "// Requires: LSX")
+	fmt.Fprintln(w, "TEXT ·keccakF1600x2LSX(SB), NOSPLIT, $0-8")
+	emit("MOVV a+0(FP), R4")
+	for i := 0; i < 25; i++ {
+		emit("VMOVQ %d(R4), V%d", 16*i, i)
+	}
+	emit("MOVV $·rc(SB), R5")
+	emit("MOVV $24, R6")
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "loop:")
+
+	// θ: the column parities, then each D[x] = C[x-1] ^ ROL(C[x+1], 1),
+	// folded into its column at once.
+	for x := 0; x < 5; x++ {
+		emit("VXORV V%d, V%d, V%d", x, x+5, c0+x)
+		for y := 10; y < 25; y += 5 {
+			emit("VXORV V%d, V%d, V%d", x+y, c0+x, c0+x)
+		}
+	}
+	for x := 0; x < 5; x++ {
+		rotate(work, c0+(x+1)%5, 1)
+		emit("VXORV V%d, V%d, V%d", c0+(x+4)%5, work, work)
+		for y := 0; y < 25; y += 5 {
+			emit("VXORV V%d, V%d, V%d", work, x+y, x+y)
+		}
+	}
+
+	// ρ and π: starting from lane 1, each lane receives the rotated lane
+	// that π moves to it, until the cycle comes back to lane 1.
+	emit("VMOVQ V1, V%d", saved)
+	for dst := 1; ; {
+		// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+		x, y := dst%5, dst/5
+		src := (x+3*y)%5 + 5*x
+		if src == 1 {
+			rotate(dst, saved, rho[src])
+			break
+		}
+		rotate(dst, src, rho[src])
+		dst = src
+	}
+
+	// χ: the five terms of each row first, then XORed into it. VANDNV
+	// computes its first operand AND NOT its second.
+	for y := 0; y < 25; y += 5 {
+		for x := 0; x < 5; x++ {
+			emit("VANDNV V%d, V%d, V%d", y+(x+2)%5, y+(x+1)%5, chiT+x)
+		}
+		for x := 0; x < 5; x++ {
+			emit("VXORV V%d, V%d, V%d", chiT+x, y+x, y+x)
+		}
+	}
+
+	// ι
+	emit("VMOVQ (R5), V%d.V2", rcV)
+	emit("VXORV V%d, V0, V0", rcV)
+	emit("ADDV $8, R5")
+	emit("SUBV $1, R6")
+	emit("BNE R6, loop")
+
+	fmt.Fprintln(w)
+	for i := 0; i < 25; i++ {
+		emit("VMOVQ V%d, %d(R4)", i, 16*i)
+	}
+	emit("RET")
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_neon_arm.s, the implementation of
+// Keccak-f[1600] for 32-bit ARM CPUs with NEON. Run it from its directory
+// with
+//
+//	go run . -out ../../keccakf_neon_arm.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format. The Go assembler for arm does not know the
+// NEON instructions either, so they are encoded here and written as WORD
+// directives, each followed by the instruction in the syntax of the ARM
+// manual.
+//
+// NEON shifts 64-bit lanes, so the lanes are not bit-interleaved: each of
+// D0 to D24 holds a lane for the whole permutation, and D25 to D31 are
+// temporaries. A rotation is a VSHL and a VSRI, which inserts the bits
+// shifted out. The rounds are in a loop, as an unrolled permutation would
+// not fit in the instruction cache of the smaller cores, and ρ and π move
+// the lanes around the cycle of π so that each round leaves them where the
+// next one expects them.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: D0 to D24 hold the lanes, R0 points to the state, R1
+// to the next round constant, and R2 counts the rounds.
+const (
+	c0   = 25 // C[0] to C[4] in D25 to D29, which then hold D[1] to D[4]
+	d0   = 30 // D[0]
+	tmp  = 31
+	rcD  = 25 // the round constant
+	chiT = 25 // the five terms of χ in D25 to D29
+)
+
+func main() {
+	out := flag.String("out", "keccakf_neon_arm.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// The encodings of the A1 forms of the instructions used, from the Arm
+// Architecture Reference Manual for Armv7-A, with the registers zero.
+const (
+	opVEOR  = 0xf3000110
+	opVBIC  = 0xf2100110
+	opVORR  = 0xf2200110
+	opVSHL  = 0xf2800590 // VSHL.I64 by an immediate
+	opVSRI  = 0xf3800490 // VSRI.64
+	opVLD1  = 0xf42007cd // VLD1.64 of one register, with writeback
+	opVLDM  = 0xec900b00 // VLDMIA
+	opVSTM  = 0xec800b00 // VSTMIA
+	bitW    = 1 << 21    // writeback for VLDM and VSTM
+	regMask = 0xf
+)
+
+// vd, vn and vm place the register Dn in the fields of the destination and
+// of the first and second operands.
+func vd(n int) uint32 { return uint32(n&regMask)<<12 | uint32(n>>4)<<22 }
+func vn(n int) uint32 { return uint32(n&regMask)<<16 | uint32(n>>4)<<7 }
+func vm(n int) uint32 { return uint32(n&regMask) | uint32(n>>4)<<5 }
+
+type generator struct {
+	w *bufio.Writer
+}
+
+func (g *generator) emit(format string, args ...any) {
+	fmt.Fprintf(g.w, "\t"+format+"\n", args...)
+}
+
+// word emits an instruction the Go assembler does not know.
+func (g *generator) word(enc uint32, format string, args ...any) {
+	g.emit("WORD $0x%08x // %s", enc, fmt.Sprintf(format, args...))
+}
+
+func (g *generator) veor(d, n, m int) {
+	g.word(opVEOR|vd(d)|vn(n)|vm(m), "veor d%d, d%d, d%d", d, n, m)
+}
+
+// vbic sets Dd to Dn AND NOT Dm.
+func (g *generator) vbic(d, n, m int) {
+	g.word(opVBIC|vd(d)|vn(n)|vm(m), "vbic d%d, d%d, d%d", d, n, m)
+}
+
+func (g *generator) vmov(d, m int) {
+	g.word(opVORR|vd(d)|vn(m)|vm(m), "vmov d%d, d%d", d, m)
+}
+
+// rotate sets Dd to Dm rotated left by n bits, 0 < n < 64.
+func (g *generator) rotate(d, m, n int) {
+	g.word(opVSHL|uint32(n)<<16|vd(d)|vm(m), "vshl.i64 d%d, d%d, #%d", d, m, n)
+	g.word(opVSRI|uint32(n)<<16|vd(d)|vm(m), "vsri.64 d%d, d%d, #%d", d, m, 64-n)
+}
+
+// vldm and vstm load and store count registers from Dd on at Rn.
+func (g *generator) vldm(n int, writeback bool, d, count int) {
+	enc, wb := uint32(opVLDM)|uint32(n)<<16|vd(d)|uint32(2*count), ""
+	if writeback {
+		enc, wb = enc|bitW, "!"
+	}
+	g.word(enc, "vldmia r%d%s, {d%d-d%d}", n, wb, d, d+count-1)
+}
+
+func (g *generator) vstm(n int, writeback bool, d, count int) {
+	enc, wb := uint32(opVSTM)|uint32(n)<<16|vd(d)|uint32(2*count), ""
+	if writeback {
+		enc, wb = enc|bitW, "!"
+	}
+	g.word(enc, "vstmia r%d%s, {d%d-d%d}", n, wb, d, d+count-1)
+}
+
+func generate(w *bufio.Writer, out string) {
+	g := &generator{w: w}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_neon_arm_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build arm && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "#include \"textflag.h\"")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func keccakF1600NEON(a *[25]uint64)")
+	fmt.Fprintln(w, "// Requires: NEON")
+	fmt.Fprintln(w, "TEXT ·keccakF1600NEON(SB), NOSPLIT, $0-4")
+	g.emit("MOVW a+0(FP), R0")
+	g.emit("MOVW $·rc(SB), R1")
+	g.emit("MOVW $24, R2")
+	g.vldm(0, true, 0, 16)
+	g.vldm(0, false, 16, 9)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "loop:")
+
+	// θ: the column parities, then D[x] = C[x-1] ^ ROL(C[x+1], 1), each in
+	// the register of a parity no longer needed, except for D[0].
+	for x := 0; x < 5; x++ {
+		g.veor(c0+x, x, x+5)
+		for y := 10; y < 25; y += 5 {
+			g.veor(c0+x, c0+x, x+y)
+		}
+	}
+	var d [5]int
+	for _, x := range []int{0, 2, 4, 1, 3} {
+		g.rotate(tmp, c0+(x+1)%5, 1)
+		if x == 0 {
+			d[x] = d0
+			g.veor(d[x], c0+4, tmp)
+		} else {
+			d[x] = c0 + (x+4)%5
+			g.veor(d[x], d[x], tmp)
+		}
+	}
+	for i := 0; i < 25; i++ {
+		g.veor(i, i, d[i%5])
+	}
+
+	// ρ and π: starting from lane 1, each lane receives the rotated lane
+	// that π moves to it, until the cycle comes back to lane 1.
+	g.vmov(tmp, 1)
+	for dst := 1; ; {
+		// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+		x, y := dst%5, dst/5
+		src := (x+3*y)%5 + 5*x
+		if src == 1 {
+			g.rotate(dst, tmp, rho[src])
+			break
+		}
+		g.rotate(dst, src, rho[src])
+		dst = src
+	}
+
+	// χ: the five terms of each row first, then XORed into it.
+	for y := 0; y < 25; y += 5 {
+		for x := 0; x < 5; x++ {
+			g.vbic(chiT+x, y+(x+2)%5, y+(x+1)%5)
+		}
+		for x := 0; x < 5; x++ {
+			g.veor(y+x, y+x, chiT+x)
+		}
+	}
+
+	// ι
+	g.word(opVLD1|vd(rcD)|1<<16, "vld1.64 {d%d}, [r1]!", rcD)
+	g.veor(0, 0, rcD)
+
+	g.emit("SUB.S $1, R2")
+	g.emit("BNE loop")
+	fmt.Fprintln(w)
+	g.emit("MOVW a+0(FP), R0")
+	g.vstm(0, true, 0, 16)
+	g.vstm(0, false, 16, 9)
+	g.emit("RET")
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_rvv_riscv64.s, which applies
+// Keccak-f[1600] to any number of interleaved states with the RISC-V vector
+// extension. Run it from its directory with
+//
+//	go run . -out ../../keccakf_rvv_riscv64.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format.
+//
+// Each of V0 to V24 holds the same lane of as many states as a vector
+// register of 64-bit elements has room for, which depends on the CPU, and
+// V25 to V31 are temporaries. The states are taken in groups of that many,
+// VSETVLI giving the size of each group, so the same code serves every
+// vector length. Only the base vector extension is used: a rotation is
+// two shifts and an OR, as in the SSE2 code, and χ complements its operand
+// with an XOR. The rounds are in a loop, and ρ and π move the lanes around
+// the cycle of π, as in the NEON implementation for 32-bit ARM.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: X10 points to the lanes of the current group, X11
+// counts the states left, X12 is the distance between two lanes of a
+// state, and X13 is the size of the group. X14 walks the lanes, X15 points
+// to the next round constant, X16 counts the rounds, and X17 and X18 are
+// temporaries.
+const (
+	c0    = 25 // C[0] to C[4] in V25 to V29
+	work  = 30 // each D[x] in turn
+	tmp   = 31
+	saved = 25 // lane 1 during ρ and π
+	chiT  = 25 // the five terms of χ in V25 to V29
+)
+
+func main() {
+	out := flag.String("out", "keccakf_rvv_riscv64.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(w *bufio.Writer, out string) {
+	emit := func(format string, args ...any) {
+		fmt.Fprintf(w, "\t"+format+"\n", args...)
+	}
+
+	// shift emits a vector shift of s by n bits into d. The immediate forms
+	// only take amounts up to 31.
+	shift := func(op string, n, s, d int) {
+		if n < 32 {
+			emit("%sVI $%d, V%d, V%d", op, n, s, d)
+		} else {
+			emit("MOV $%d, X18", n)
+			emit("%sVX X18, V%d, V%d", op, s, d)
+		}
+	}
+	// rotate sets Vd to Vs rotated left by n bits, 0 < n < 64, using tmp.
+	rotate := func(d, s, n int) {
+		shift("VSLL", n, s, d)
+		shift("VSRL", 64-n, s, tmp)
+		emit("VORVV V%d, V%d, V%d", tmp, d, d)
+	}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_rvv_riscv64_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build riscv64 && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "#include \"textflag.h\"")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func keccakF1600RVV(a *uint64, n int)")
+	fmt.Fprintln(w, "// Requires: V")
+	fmt.Fprintln(w, "TEXT ·keccakF1600RVV(SB), NOSPLIT, $0-16")
+	emit("MOV a+0(FP), X10")
+	emit("MOV n+8(FP), X11")
+	emit("SLLI $3, X11, X12")
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "group:")
+	emit("VSETVLI X11, E64, M1, TA, MA, X13")
+	emit("MOV X10, X14")
+	for i := 0; i < 25; i++ {
+		emit("VLE64V (X14), V%d", i)
+		if i < 24 {
+			emit("ADD X12, X14, X14")
+		}
+	}
+	emit("MOV $·rc(SB), X15")
+	emit("MOV $24, X16")
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "round:")
+
+	// θ: the column parities, then each D[x] = C[x-1] ^ ROL(C[x+1], 1),
+	// folded into its column at once.
+	for x := 0; x < 5; x++ {
+		emit("VXORVV V%d, V%d, V%d", x+5, x, c0+x)
+		for y := 10; y < 25; y += 5 {
+			emit("VXORVV V%d, V%d, V%d", x+y, c0+x, c0+x)
+		}
+	}
+	for x := 0; x < 5; x++ {
+		rotate(work, c0+(x+1)%5, 1)
+		emit("VXORVV V%d, V%d, V%d", c0+(x+4)%5, work, work)
+		for y := 0; y < 25; y += 5 {
+			emit("VXORVV V%d, V%d, V%d", work, x+y, x+y)
+		}
+	}
+
+	// ρ and π: starting from lane 1, each lane receives the rotated lane
+	// that π moves to it, until the cycle comes back to lane 1.
+	emit("VMVVV V1, V%d", saved)
+	for dst := 1; ; {
+		// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+		x, y := dst%5, dst/5
+		src := (x+3*y)%5 + 5*x
+		if src == 1 {
+			rotate(dst, saved, rho[src])
+			break
+		}
+		rotate(dst, src, rho[src])
+		dst = src
+	}
+
+	// χ: the five terms of each row first, then XORed into it.
+	for y := 0; y < 25; y += 5 {
+		for x := 0; x < 5; x++ {
+			emit("VNOTV V%d, V%d", y+(x+1)%5, chiT+x)
+			emit("VANDVV V%d, V%d, V%d", y+(x+2)%5, chiT+x, chiT+x)
+		}
+		for x := 0; x < 5; x++ {
+			emit("VXORVV V%d, V%d, V%d", chiT+x, y+x, y+x)
+		}
+	}
+
+	// ι
+	emit("MOV (X15), X17")
+	emit("VXORVX X17, V0, V0")
+	emit("ADD $8, X15")
+	emit("SUB $1, X16")
+	emit("BNEZ X16, round")
+
+	fmt.Fprintln(w)
+	emit("MOV X10, X14")
+	for i := 0; i < 25; i++ {
+		emit("VSE64V V%d, (X14)", i)
+		if i < 24 {
+			emit("ADD X12, X14, X14")
+		}
+	}
+	emit("SLLI $3, X13, X17")
+	emit("ADD X17, X10")
+	emit("SUB X13, X11")
+	emit("BNEZ X11, group")
+	emit("RET")
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func rvvLanes() int")
+	fmt.Fprintln(w, "// Requires: V")
+	fmt.Fprintln(w, "TEXT ·rvvLanes(SB), NOSPLIT, $0-8")
+	emit("VSETVLI X0, E64, M1, TA, MA, X10")
+	emit("MOV X10, ret+0(FP)")
+	emit("RET")
+}
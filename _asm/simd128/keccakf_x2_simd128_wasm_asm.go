@@ -0,0 +1,206 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_x2_simd128_wasm.s, which applies
+// Keccak-f[1600] to two interleaved states at once with the SIMD128
+// instructions of WebAssembly. Run it from its directory with
+//
+//	go run . -out ../../keccakf_x2_simd128_wasm.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format.
+//
+// WebAssembly is a stack machine, and the Go assembler has only 16 v128
+// locals, V0 to V15, so the state stays in memory, each 16-byte lane
+// holding the same lane of both states. Each round reads it three times:
+// θ computes the column parities, ρ and π move the lanes around the cycle
+// of π in place, as in the NEON implementation for 32-bit ARM, and χ
+// rewrites it row by row. A rotation is two shifts and an OR, as in the
+// SSE2 code. The engine that compiles the module allocates the registers.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: R0 points to the state, R1 to the next round
+// constant, and R2 past the last one. V0 to V4 hold C[0] to C[4] and then
+// the row of χ, V5 to V9 hold D[0] to D[4], V10 is a temporary, and V11
+// holds lane 1 during ρ and π.
+const (
+	c0    = 0
+	d0    = 5
+	row   = 0
+	tmp   = 10
+	saved = 11
+)
+
+func main() {
+	out := flag.String("out", "keccakf_x2_simd128_wasm.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(w *bufio.Writer, out string) {
+	emit := func(format string, args ...any) {
+		fmt.Fprintf(w, "\t"+format+"\n", args...)
+	}
+	// state pushes the address of the state, and at pushes that of lane i
+	// for a store. The Go assembler drops the offset of V128Store, which is
+	// missing from its list of instructions whose operand is a destination,
+	// so the stores add it to the address.
+	state := func() {
+		emit("Get R0")
+		emit("I32WrapI64")
+	}
+	at := func(i int) {
+		state()
+		if i > 0 {
+			emit("I32Const $%d", 16*i)
+			emit("I32Add")
+		}
+	}
+	load := func(i int) {
+		state()
+		emit("V128Load $%d", 16*i)
+	}
+	store := func() {
+		emit("V128Store $0")
+	}
+	// rotate rotates the vector on top of the stack left by n bits,
+	// 0 < n < 64.
+	rotate := func(n int) {
+		emit("Tee V%d", tmp)
+		emit("I32Const $%d", n)
+		emit("I64x2Shl")
+		emit("Get V%d", tmp)
+		emit("I32Const $%d", 64-n)
+		emit("I64x2ShrU")
+		emit("V128Or")
+	}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_x2_simd128_wasm_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build wasm && keccak_simd128 && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "#include \"textflag.h\"")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func keccakF1600x2SIMD128(a *[25][2]uint64)")
+	fmt.Fprintln(w, "// Requires: SIMD128")
+	fmt.Fprintln(w, "TEXT ·keccakF1600x2SIMD128(SB), NOSPLIT, $0-8")
+	emit("MOVD a+0(FP), R0")
+	emit("MOVD $·rc(SB), R1")
+	emit("Get R1")
+	emit("I64Const $%d", 8*24)
+	emit("I64Add")
+	emit("Set R2")
+	fmt.Fprintln(w)
+	emit("Loop")
+
+	// θ: the column parities, then each D[x] = C[x-1] ^ ROL(C[x+1], 1).
+	for x := 0; x < 5; x++ {
+		load(x)
+		for y := 5; y < 25; y += 5 {
+			load(x + y)
+			emit("V128Xor")
+		}
+		emit("Set V%d", c0+x)
+	}
+	for x := 0; x < 5; x++ {
+		emit("Get V%d", c0+(x+4)%5)
+		emit("Get V%d", c0+(x+1)%5)
+		rotate(1)
+		emit("V128Xor")
+		emit("Set V%d", d0+x)
+	}
+
+	// θ, ρ and π: starting from lane 1, each lane receives the lane that
+	// π moves to it, with D added and rotated, until the cycle comes back
+	// to lane 1. Lane 0 only takes D[0].
+	at(0)
+	load(0)
+	emit("Get V%d", d0)
+	emit("V128Xor")
+	store()
+	load(1)
+	emit("Set V%d", saved)
+	for dst := 1; ; {
+		// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+		x, y := dst%5, dst/5
+		src := (x+3*y)%5 + 5*x
+		at(dst)
+		if src == 1 {
+			emit("Get V%d", saved)
+		} else {
+			load(src)
+		}
+		emit("Get V%d", d0+src%5)
+		emit("V128Xor")
+		rotate(rho[src])
+		store()
+		if src == 1 {
+			break
+		}
+		dst = src
+	}
+
+	// χ and ι, row by row. V128Andnot computes its first operand AND NOT
+	// its second.
+	for y := 0; y < 25; y += 5 {
+		for x := 0; x < 5; x++ {
+			load(y + x)
+			emit("Set V%d", row+x)
+		}
+		for x := 0; x < 5; x++ {
+			at(y + x)
+			emit("Get V%d", row+x)
+			emit("Get V%d", row+(x+2)%5)
+			emit("Get V%d", row+(x+1)%5)
+			emit("V128Andnot")
+			emit("V128Xor")
+			if y+x == 0 {
+				emit("Get R1")
+				emit("I32WrapI64")
+				emit("I64Load $0")
+				emit("I64x2Splat")
+				emit("V128Xor")
+			}
+			store()
+		}
+	}
+
+	emit("Get R1")
+	emit("I64Const $8")
+	emit("I64Add")
+	emit("Tee R1")
+	emit("Get R2")
+	emit("I64Ne")
+	emit("BrIf $0")
+	emit("End")
+	emit("RET")
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_x2_sse2_386.s, which applies
+// Keccak-f[1600] to two interleaved states at once with SSE2. Run it from
+// its directory with
+//
+//	go run . -out ../../keccakf_x2_sse2_386.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format.
+//
+// Each 128-bit register holds the same lane of both states, so the code is
+// that of the single-state SSE2 implementation, with full-width loads and
+// stores and the round constant copied to both halves.
+//
+// There is no amd64 version: with only shifts to rotate, it is slower than
+// running the scalar assembly twice.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: DI points to the caller's state and SP to the
+// temporary one, followed by the five θ effects. The column parities of θ
+// live in the row registers until the θ effects are computed.
+var (
+	row  = [5]string{"X0", "X1", "X2", "X3", "X4"}
+	tmp  = "X5"
+	tmp2 = "X6"
+)
+
+func main() {
+	out := flag.String("out", "keccakf_x2_sse2_386.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(w *bufio.Writer, out string) {
+	emit := func(format string, args ...any) {
+		fmt.Fprintf(w, "\t"+format+"\n", args...)
+	}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_x2_sse2_386_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build 386 && 386.sse2 && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func keccakF1600x2SSE2(a *[25][2]uint64)")
+	fmt.Fprintln(w, "// Requires: SSE2")
+	fmt.Fprintln(w, "TEXT ·keccakF1600x2SSE2(SB), $480-4")
+	emit("MOVL a+0(FP), DI")
+
+	state := func(base string, i int) string { return fmt.Sprintf("%d(%s)", 16*i, base) }
+	theta := func(x int) string { return fmt.Sprintf("%d(SP)", 400+16*x) }
+
+	// rotate rotates both lanes in r left by n bits, using tmp.
+	rotate := func(r string, n int) {
+		emit("MOVO %s, %s", r, tmp)
+		emit("PSLLQ $%d, %s", n, r)
+		emit("PSRLQ $%d, %s", 64-n, tmp)
+		emit("POR %s, %s", tmp, r)
+	}
+
+	for round := 0; round < 24; round++ {
+		src, dst := "DI", "SP"
+		if round%2 == 1 {
+			src, dst = "SP", "DI"
+		}
+		fmt.Fprintln(w)
+		emit("// Round %d", round)
+
+		// θ
+		for x := 0; x < 5; x++ {
+			emit("MOVOU %s, %s", state(src, x), row[x])
+			for y := 5; y < 25; y += 5 {
+				emit("MOVOU %s, %s", state(src, x+y), tmp)
+				emit("PXOR %s, %s", tmp, row[x])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			emit("MOVO %s, %s", row[(x+1)%5], tmp2)
+			rotate(tmp2, 1)
+			emit("PXOR %s, %s", row[(x+4)%5], tmp2)
+			emit("MOVOU %s, %s", tmp2, theta(x))
+		}
+
+		// ρ and π gather each output row, and χ combines it.
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+				sx := (x + 3*y) % 5
+				i := sx + 5*x
+				emit("MOVOU %s, %s", state(src, i), row[x])
+				emit("MOVOU %s, %s", theta(sx), tmp)
+				emit("PXOR %s, %s", tmp, row[x])
+				if rho[i] != 0 {
+					rotate(row[x], rho[i])
+				}
+			}
+			for x := 0; x < 5; x++ {
+				// PANDN computes the complement of its destination ANDed
+				// with its source.
+				emit("MOVO %s, %s", row[(x+1)%5], tmp2)
+				emit("PANDN %s, %s", row[(x+2)%5], tmp2)
+				emit("PXOR %s, %s", row[x], tmp2)
+				if x == 0 && y == 0 {
+					emit("MOVQ ·rc+%d(SB), %s", 8*round, tmp)
+					emit("PUNPCKLQDQ %s, %s", tmp, tmp)
+					emit("PXOR %s, %s", tmp, tmp2)
+				}
+				emit("MOVOU %s, %s", tmp2, state(dst, x+5*y))
+			}
+		}
+	}
+	emit("RET")
+}
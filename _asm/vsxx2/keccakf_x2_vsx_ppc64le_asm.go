@@ -0,0 +1,190 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_x2_vsx_ppc64le.s, which applies
+// Keccak-f[1600] to two interleaved states at once with the vector
+// instructions of POWER8 and later. Run it from its directory with
+//
+//	go run . -out ../../keccakf_x2_vsx_ppc64le.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format.
+//
+// Each of the 32 vector registers V0 to V31 holds two doublewords, and
+// V0 to V24 hold the same lane of both states for the whole permutation.
+// VRLD rotates each doubleword by the amount in the same doubleword of
+// another register, so a rotation is a load of its amount, from a table
+// indexed by lane, and a VRLD. The offsets into the table, which are also
+// those of the lanes in the state, are kept in general-purpose registers.
+// The rounds are in a loop, as in the NEON implementation for 32-bit ARM:
+// ρ and π move the lanes around the cycle of π, so that each round leaves
+// them where the next one expects them.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Register allocation: V0 to V24 hold the lanes and V25 to V31 are
+// temporaries. R3 points to the state, and then to the next round
+// constant, R4 to the rotation table, and offset[k] holds 16k.
+var offset = [24]string{
+	1: "R5", "R6", "R7", "R8", "R9", "R10", "R11", "R12",
+	"R14", "R15", "R16", "R17", "R18", "R19", "R20", "R21",
+	"R22", "R23", "R24", "R25", "R26", "R27", "R28",
+}
+
+const (
+	c0    = 25 // C[0] to C[4] in V25 to V29
+	work  = 30 // each D[x] in turn
+	one   = 31 // the amount of the rotations of θ
+	saved = 31 // lane 1 during ρ and π
+	chiT  = 25 // the five terms of χ in V25 to V29
+	rcV   = 25 // the round constant
+)
+
+func main() {
+	out := flag.String("out", "keccakf_x2_vsx_ppc64le.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// indexed returns the memory operand at base plus 16k.
+func indexed(base string, k int) string {
+	if k == 0 {
+		return fmt.Sprintf("(%s)", base)
+	}
+	return fmt.Sprintf("(%s)(%s)", base, offset[k])
+}
+
+// vs returns the name of Vn as a VSX register.
+func vs(n int) string { return fmt.Sprintf("VS%d", 32+n) }
+
+func generate(w *bufio.Writer, out string) {
+	emit := func(format string, args ...any) {
+		fmt.Fprintf(w, "\t"+format+"\n", args...)
+	}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_x2_vsx_ppc64le_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build ppc64le && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "#include \"textflag.h\"")
+	fmt.Fprintln(w)
+
+	// The rotation amounts of lanes 1 to 24, in both doublewords.
+	for i := 1; i < 25; i++ {
+		fmt.Fprintf(w, "DATA vsxRho<>+%d(SB)/8, $%d\n", 16*(i-1), rho[i])
+		fmt.Fprintf(w, "DATA vsxRho<>+%d(SB)/8, $%d\n", 16*(i-1)+8, rho[i])
+	}
+	fmt.Fprintln(w, "GLOBL vsxRho<>(SB), RODATA|NOPTR, $384")
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "// func keccakF1600x2VSX(a *[25][2]uint64)")
+	fmt.Fprintln(w, "TEXT ·keccakF1600x2VSX(SB), NOSPLIT, $0-8")
+	for k := 1; k < 24; k++ {
+		emit("MOVD $%d, %s", 16*k, offset[k])
+	}
+	emit("MOVD a+0(FP), R3")
+	for i := 0; i < 24; i++ {
+		emit("LXVD2X %s, %s", indexed("R3", i), vs(i))
+	}
+	emit("ADD $16, R3")
+	emit("LXVD2X %s, %s", indexed("R3", 23), vs(24))
+	emit("MOVD $24, R4")
+	emit("MOVD R4, CTR")
+	emit("MOVD $vsxRho<>(SB), R4")
+	emit("MOVD $·rc(SB), R3")
+
+	// rotate sets Vd to Vs rotated by the amount of lane i, loaded in Vt.
+	rotate := func(d, s, i, t int) {
+		emit("LXVD2X %s, %s", indexed("R4", i-1), vs(t))
+		emit("VRLD V%d, V%d, V%d", s, t, d)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "loop:")
+
+	// θ: the column parities, then each D[x] = C[x-1] ^ ROL(C[x+1], 1),
+	// folded into its column at once.
+	for x := 0; x < 5; x++ {
+		emit("VXOR V%d, V%d, V%d", x, x+5, c0+x)
+		for y := 10; y < 25; y += 5 {
+			emit("VXOR V%d, V%d, V%d", c0+x, x+y, c0+x)
+		}
+	}
+	emit("LXVD2X %s, %s", indexed("R4", 0), vs(one))
+	for x := 0; x < 5; x++ {
+		emit("VRLD V%d, V%d, V%d", c0+(x+1)%5, one, work)
+		emit("VXOR V%d, V%d, V%d", work, c0+(x+4)%5, work)
+		for y := 0; y < 25; y += 5 {
+			emit("VXOR V%d, V%d, V%d", x+y, work, x+y)
+		}
+	}
+
+	// ρ and π: starting from lane 1, each lane receives the rotated lane
+	// that π moves to it, until the cycle comes back to lane 1.
+	emit("VOR V1, V1, V%d", saved)
+	for dst, t := 1, 0; ; t = (t + 1) % 6 {
+		// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+		x, y := dst%5, dst/5
+		src := (x+3*y)%5 + 5*x
+		if src == 1 {
+			rotate(dst, saved, src, chiT+t)
+			break
+		}
+		rotate(dst, src, src, chiT+t)
+		dst = src
+	}
+
+	// χ: the five terms of each row first, then XORed into it. VANDC
+	// computes its first operand AND NOT its second.
+	for y := 0; y < 25; y += 5 {
+		for x := 0; x < 5; x++ {
+			emit("VANDC V%d, V%d, V%d", y+(x+2)%5, y+(x+1)%5, chiT+x)
+		}
+		for x := 0; x < 5; x++ {
+			emit("VXOR V%d, V%d, V%d", y+x, chiT+x, y+x)
+		}
+	}
+
+	// ι
+	emit("LXVDSX (R3), %s", vs(rcV))
+	emit("ADD $8, R3")
+	emit("VXOR V0, V%d, V0", rcV)
+
+	emit("BC 16, 0, loop")
+	fmt.Fprintln(w)
+	emit("MOVD a+0(FP), R3")
+	for i := 0; i < 24; i++ {
+		emit("STXVD2X %s, %s", vs(i), indexed("R3", i))
+	}
+	emit("ADD $16, R3")
+	emit("STXVD2X %s, %s", vs(24), indexed("R3", 23))
+	emit("RET")
+}
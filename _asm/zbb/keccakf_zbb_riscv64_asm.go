@@ -0,0 +1,296 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This program generates keccakf_zbb_riscv64.s, the implementation of
+// Keccak-f[1600] in the riscv64 general-purpose registers with the RORI and
+// ANDN instructions of the Zbb extension. Run it from its directory with
+//
+//	go run . -out ../../keccakf_zbb_riscv64.s
+//
+// avo only generates amd64 code, so this program prints the assembly
+// itself, in the same format. The Go assembler only emits RORI and ANDN
+// when GORISCV64 promises Zbb, and replaces them with several instructions
+// otherwise, so they are encoded here and written as WORD directives, each
+// followed by the instruction in the syntax of the RISC-V manual. This lets
+// the code be chosen at run time.
+//
+// The registers are allocated as in the scalar implementation for arm64:
+// the state lives in registers for the whole permutation, except for six
+// lanes that wait on the stack between χ and θ, and two during χ. The 24
+// rounds are unrolled, the mapping from lanes to registers tracked by the
+// generator, which makes π free. RISC-V has no rotated operands, so ρ
+// rotates every lane with a RORI.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// The registers available: all but X0, which is zero, X2, the stack
+// pointer, X3 and X4, the global and thread pointers, X27, which holds g,
+// and X31, which the assembler uses for the addresses of the round
+// constants. X1, the link register, is saved on the stack by the prologue
+// the assembler adds to a function with a frame, and restored before it
+// returns.
+var registers = []string{
+	"X1", "X5", "X6", "X7", "X8", "X9", "X10", "X11", "X12", "X13",
+	"X14", "X15", "X16", "X17", "X18", "X19", "X20", "X21", "X22", "X23",
+	"X24", "X25", "X26", "X28", "X29", "X30",
+}
+
+// parked[k] is a lane kept on the stack between χ and θ, at 8+8k(SP), two
+// of them in column 0, whose lanes take D[0] first. Two lanes of row 4 also
+// wait at 56(SP) and 64(SP) during χ.
+var parked = [6]int{5, 10, 11, 17, 23, 4}
+
+func main() {
+	out := flag.String("out", "keccakf_zbb_riscv64.s", "output file")
+	flag.Parse()
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	generate(w, *out)
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	w    *bufio.Writer
+	free []string
+}
+
+func (g *generator) emit(format string, args ...any) {
+	fmt.Fprintf(g.w, "\t"+format+"\n", args...)
+}
+
+func (g *generator) alloc() string {
+	if len(g.free) == 0 {
+		log.Fatal("out of registers")
+	}
+	r := g.free[len(g.free)-1]
+	g.free = g.free[:len(g.free)-1]
+	return r
+}
+
+func (g *generator) release(r string) {
+	g.free = append(g.free, r)
+}
+
+// number returns the number of register r.
+func number(r string) uint32 {
+	n, err := strconv.Atoi(r[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	return uint32(n)
+}
+
+// rotl sets dst to src rotated left by n bits, 0 < n < 64.
+func (g *generator) rotl(dst, src string, n int) {
+	const opRORI = 0x60005013
+	enc := opRORI | uint32(64-n)<<20 | number(src)<<15 | number(dst)<<7
+	g.emit("WORD $0x%08x // rori x%d, x%d, %d", enc, number(dst), number(src), 64-n)
+}
+
+// andn sets dst to a AND NOT b.
+func (g *generator) andn(dst, a, b string) {
+	const opANDN = 0x40007033
+	enc := opANDN | number(b)<<20 | number(a)<<15 | number(dst)<<7
+	g.emit("WORD $0x%08x // andn x%d, x%d, x%d", enc, number(dst), number(a), number(b))
+}
+
+// xor sets dst to a XOR b.
+func (g *generator) xor(dst, a, b string) {
+	g.emit("XOR %s, %s, %s", b, a, dst)
+}
+
+func generate(w *bufio.Writer, out string) {
+	g := &generator{w: w}
+	for i := len(registers) - 1; i >= 0; i-- {
+		g.release(registers[i])
+	}
+
+	fmt.Fprintf(w, "// Code generated by command: go run keccakf_zbb_riscv64_asm.go -out %s. DO NOT EDIT.\n", out)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "//go:build riscv64 && !purego && !noasm && gc")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "#include \"textflag.h\"")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// func keccakF1600Zbb(a *[25]uint64)")
+	fmt.Fprintln(w, "// Requires: Zbb")
+	fmt.Fprintln(w, "TEXT ·keccakF1600Zbb(SB), NOSPLIT, $64-8")
+
+	// lanes[i] is the register of lane i, or "" for a parked lane.
+	var lanes [25]string
+	slot := func(k int) string { return fmt.Sprintf("%d(SP)", 8+8*k) }
+	park := func(i, k int) {
+		g.emit("MOV %s, %s", lanes[i], slot(k))
+		g.release(lanes[i])
+		lanes[i] = ""
+	}
+	unpark := func(i, k int) {
+		lanes[i] = g.alloc()
+		g.emit("MOV %s, %s", slot(k), lanes[i])
+	}
+
+	ptr := g.alloc()
+	g.emit("MOV a+0(FP), %s", ptr)
+	for i := range lanes {
+		lanes[i] = g.alloc()
+		g.emit("MOV %d(%s), %s", 8*i, ptr, lanes[i])
+	}
+	g.release(ptr)
+	for k, i := range parked {
+		park(i, k)
+	}
+
+	for round := 0; round < 24; round++ {
+		fmt.Fprintln(w)
+		g.emit("// Round %d", round)
+
+		// θ: the column parities, starting from the parked lanes.
+		var c [5]string
+		for x := 0; x < 5; x++ {
+			c[x] = g.alloc()
+			first := true
+			for k, i := range parked {
+				if i%5 != x {
+					continue
+				}
+				if first {
+					g.emit("MOV %s, %s", slot(k), c[x])
+					first = false
+					continue
+				}
+				t := g.alloc()
+				g.emit("MOV %s, %s", slot(k), t)
+				g.xor(c[x], c[x], t)
+				g.release(t)
+			}
+			for y := 0; y < 5; y++ {
+				if i := x + 5*y; lanes[i] != "" {
+					g.xor(c[x], c[x], lanes[i])
+				}
+			}
+		}
+
+		// D[x] = C[x-1] ^ ROL(C[x+1], 1): D[0] in a register of its own,
+		// and the others in the register of C[x-1], in an order that
+		// keeps each C until the last D that needs it.
+		var d [5]string
+		d[0] = g.alloc()
+		g.rotl(d[0], c[1], 1)
+		g.xor(d[0], d[0], c[4])
+		t := g.alloc()
+		for _, x := range []int{2, 4, 1, 3} {
+			d[x] = c[(x+4)%5]
+			g.rotl(t, c[(x+1)%5], 1)
+			g.xor(d[x], d[x], t)
+		}
+		g.release(t)
+		g.release(c[4])
+
+		// Fold D into the lanes, column by column, so that the register
+		// of each D is free for the parked lane of the next column.
+		for x := 0; x < 5; x++ {
+			for k, i := range parked {
+				if i%5 == x {
+					unpark(i, k)
+				}
+			}
+			for y := 0; y < 5; y++ {
+				i := x + 5*y
+				g.xor(lanes[i], lanes[i], d[x])
+			}
+			g.release(d[x])
+		}
+
+		// ρ and π.
+		var b [25]string
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				// Lane (x, y) after π is lane ((x+3y)%5, x) before.
+				i := (x+3*y)%5 + 5*x
+				if rho[i] != 0 {
+					g.rotl(lanes[i], lanes[i], rho[i])
+				}
+				b[x+5*y] = lanes[i]
+			}
+		}
+		lanes = b
+
+		// χ, row by row. Each row needs three free registers, and two
+		// lanes of row 4 wait on the stack until there are enough.
+		park(23, 6)
+		park(24, 7)
+		for y := 0; y < 25; y += 5 {
+			row := lanes[y : y+5]
+			if y == 20 {
+				unpark(23, 6)
+				unpark(24, 7)
+			}
+			t := g.alloc()
+			b0, b1 := row[0], row[1]
+			p := g.alloc()
+			g.andn(p, row[2], b1)
+			g.xor(p, p, b0)
+			q := g.alloc()
+			g.andn(q, row[3], row[2])
+			g.xor(q, q, b1)
+			g.andn(t, row[4], row[3])
+			g.xor(row[2], row[2], t)
+			g.andn(t, b0, row[4])
+			g.xor(row[3], row[3], t)
+			g.andn(t, b1, b0)
+			g.xor(row[4], row[4], t)
+			row[0], row[1] = p, q
+			g.release(b0)
+			g.release(b1)
+
+			// ι
+			if y == 0 {
+				g.emit("MOV ·rc+%d(SB), %s", 8*round, t)
+				g.xor(row[0], row[0], t)
+			}
+			g.release(t)
+			for k, i := range parked {
+				if i/5 == y/5 {
+					park(i, k)
+				}
+			}
+		}
+	}
+
+	fmt.Fprintln(w)
+	g.emit("// Store the state")
+	for k, i := range parked {
+		unpark(i, k)
+	}
+	ptr = g.alloc()
+	g.emit("MOV a+0(FP), %s", ptr)
+	for i := range lanes {
+		g.emit("MOV %s, %d(%s)", lanes[i], 8*i, ptr)
+	}
+	g.emit("RET")
+}
@@ -0,0 +1,31 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the outputs of TestShakeDRBG in drbg_test.go.
+
+Every operation of ShakeDRBG is a TupleHashXOF256 of the working state V
+and its inputs, customized by the name of the operation, of which the
+last 64 bytes are the new V.
+"""
+
+from ref import ptn, tuplehash
+
+
+def derive(custom, tup, n):
+    return tuplehash(256, tup, n, b"SHAKE256-DRBG " + custom, xof=True)
+
+
+def generate(v, additional, n):
+    o = derive(b"generate", [v, additional], n + 64)
+    return o[:n], o[n:]
+
+
+v = derive(b"instantiate", [ptn(48), b"nonce", b"pers"], 64)
+out, v = generate(v, b"", 32)
+print("first output", out.hex())
+out, v = generate(v, b"add", 100)
+print("end of second output", out[68:].hex())
+v = derive(b"reseed", [v, ptn(32), b"x"], 64)
+out, v = generate(v, b"", 16)
+print("output after reseeding", out.hex())
@@ -0,0 +1,12 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the outputs of TestDuplexSequence in duplex_test.go."""
+
+from ref import Duplex, ptn
+
+d = Duplex(136)
+d.duplexing(b"abc", 0)
+print("second call", d.duplexing(b"", 136).hex())
+print("third call", d.duplexing(ptn(135), 16).hex())
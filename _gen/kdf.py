@@ -0,0 +1,11 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the keys of TestKMACKDF in kdf_test.go: the KMAC KDF of NIST
+SP 800-108r1, section 4.4, KMAC(K_IN, Context, L, Label)."""
+
+from ref import kmac, ptn
+
+print("KMACKDF128", kmac(128, ptn(32), b"context", 32, b"label").hex())
+print("KMACKDF256", kmac(256, ptn(32), b"context", 64, b"label").hex())
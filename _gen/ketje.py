@@ -0,0 +1,120 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of ketje_test.go.
+
+This is a transcription of MonkeyDuplex and MonkeyWrap from "CAESAR
+submission: Ketje v2", sections 2 and 3, https://keccak.team/files/
+Ketjev2-doc2.0.pdf, over the twisted permutation π ∘ Keccak-p ∘ π⁻¹ of
+section 4. The state is kept as bytes; the twist is applied by converting
+it to lanes around each call of the permutation.
+
+TestKetjeVectors uses the key 00 01 02 ..., the nonce 40 41 42 ... and the
+test pattern for the associated data and the message. TestKetjeChained
+seals every pair of lengths of LENGTHS, with the key and nonce of
+TestKetjeVectors, and compares the SHAKE128 digest of all the ciphertexts
+with the one printed here.
+"""
+
+import hashlib
+
+from ref import keccak_p, ptn, short
+
+INSTANCES = [
+    # name, width in bytes, rho in bytes, key size, nonce size
+    ("KetjeJr", 25, 2, 12, 10),
+    ("KetjeSr", 50, 4, 16, 16),
+    ("KetjeMinor", 100, 16, 16, 16),
+    ("KetjeMajor", 200, 32, 16, 16),
+]
+
+
+def pi(a):
+    b = [0] * 25
+    for x in range(5):
+        for y in range(5):
+            b[y + 5 * ((2 * x + 3 * y) % 5)] = a[x + 5 * y]
+    return b
+
+
+def pi_inv(b):
+    a = [0] * 25
+    for x in range(5):
+        for y in range(5):
+            a[x + 5 * y] = b[y + 5 * ((2 * x + 3 * y) % 5)]
+    return a
+
+
+class MonkeyDuplex:
+    def __init__(self, width, rho):
+        self.s = bytearray(width)
+        self.lb = width // 25
+        self.rho = rho
+
+    def f(self, rounds):
+        lanes = [int.from_bytes(self.s[i * self.lb:(i + 1) * self.lb], "little") for i in range(25)]
+        lanes = pi(keccak_p(pi_inv(lanes), rounds, 8 * len(self.s)))
+        self.s = bytearray(b"".join(v.to_bytes(self.lb, "little") for v in lanes))
+
+    def start(self, i):
+        m = bytearray(i) + b"\x01" + bytes(len(self.s) - len(i) - 1)
+        m[-1] |= 0x80
+        for j in range(len(m)):
+            self.s[j] ^= m[j]
+        self.f(12)
+
+    def step(self, sigma, frame, l, rounds=1):
+        """Absorbs sigma followed by the frame bits, given as a string of
+        '0' and '1', pads it to rho bytes and 4 bits, and returns l bytes."""
+        bits = [(c >> j) & 1 for c in sigma for j in range(8)]
+        bits += [int(b) for b in frame]
+        r = 8 * self.rho + 4
+        bits += [1] + [0] * (r - len(bits) - 2) + [1]
+        for j, b in enumerate(bits):
+            self.s[j // 8] ^= b << (j % 8)
+        self.f(rounds)
+        return bytes(self.s[:l])
+
+
+def blocks(x, rho):
+    return [x[i:i + rho] for i in range(0, len(x), rho)] or [b""]
+
+
+def xor(a, b):
+    return bytes(x ^ y for x, y in zip(a, b))
+
+
+def ketje_wrap(width, rho, key, nonce, ad, msg, taglen=16):
+    d = MonkeyDuplex(width, rho)
+    d.start(bytes([len(key) + 2]) + key + b"\x01" + nonce)
+    a, b = blocks(ad, rho), blocks(msg, rho)
+    for x in a[:-1]:
+        d.step(x, "00", 0)
+    z = d.step(a[-1], "01", len(b[0]))
+    ct = xor(b[0], z)
+    for i in range(len(b) - 1):
+        z = d.step(b[i], "11", len(b[i + 1]))
+        ct += xor(b[i + 1], z)
+    tag = d.step(b[-1], "10", rho, rounds=6)
+    while len(tag) < taglen:
+        tag += d.step(b"", "0", rho)
+    return ct + tag[:taglen]
+
+
+def lengths(rho):
+    """The lengths of TestKetjeChained: around one, two and three blocks."""
+    return sorted({0, 1, rho - 1, rho, rho + 1, 2 * rho, 2 * rho + 1, 3 * rho + 5})
+
+
+if __name__ == "__main__":
+    for name, width, rho, keylen, noncelen in INSTANCES:
+        key, nonce = bytes(range(keylen)), bytes(range(0x40, 0x40 + noncelen))
+        for adlen, msglen in [(0, 0), (3, 5), (100, 250)]:
+            print(name, adlen, msglen, short(ketje_wrap(width, rho, key, nonce, ptn(adlen), ptn(msglen))))
+
+        check = hashlib.shake_128()
+        for adlen in lengths(rho):
+            for msglen in lengths(rho):
+                check.update(ketje_wrap(width, rho, key, nonce, ptn(adlen), ptn(msglen)))
+        print(name, "chained", lengths(rho), check.hexdigest(32))
@@ -0,0 +1,128 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of keyak_test.go.
+
+This is a transcription of the Motorist mode with a single piston, from
+"CAESAR submission: Keyak v2", section 2, https://keccak.team/files/
+Keyakv2-doc2.2.pdf: the Piston, Engine and Motorist algorithms, with the
+Engine reduced to its one piston. River Keyak runs it over
+Keccak-p[800, 12], with Rs = 68 and Ra = 96, and Lake Keyak over
+Keccak-p[1600, 12], with Rs = 168 and Ra = 192.
+
+TestKeyakVectors and TestKeyakSession use the key 00 01 02 ..., the nonce
+40 41 42 ... and the test pattern for the associated data and the
+messages. TestKeyakChained seals, in one session, a message for every pair
+of lengths of lengths(); each message is thus authenticated together with
+all the ones before it. It then starts one session for every key size,
+whose key and nonce are the start of the last ciphertext, and seals a
+message in each. The SHAKE128 digest of all the ciphertexts is compared
+with the one printed here.
+"""
+
+import hashlib
+
+from ref import permute_bytes, ptn, short
+
+INSTANCES = [
+    # name, width in bytes, Rs, Ra
+    ("RiverKeyak", 100, 68, 96),
+    ("LakeKeyak", 200, 168, 192),
+]
+
+
+class Piston:
+    def __init__(self, width, rs, ra):
+        self.s = bytearray(width)
+        self.rs, self.ra = rs, ra
+        self.et = 0
+
+    def crypt(self, inp, unwrap):
+        """Encrypts or decrypts up to one block of inp, consuming it."""
+        o, out = self.et, bytearray()
+        while inp and o < self.rs:
+            x = inp.pop(0)
+            if unwrap:
+                p = self.s[o] ^ x
+                out.append(p)
+                self.s[o] ^= p
+            else:
+                self.s[o] ^= x
+                out.append(self.s[o])
+            o += 1
+        self.s[self.ra + 1] ^= o
+        return bytes(out)
+
+    def inject(self, x, crypting):
+        """Absorbs up to one block of the metadata x, consuming it."""
+        o = self.rs if crypting else 0
+        self.s[self.ra + 2] ^= o
+        while x and o < self.ra:
+            self.s[o] ^= x.pop(0)
+            o += 1
+        self.s[self.ra + 3] ^= o
+
+    def spark(self, eom, l):
+        if eom:
+            self.s[self.ra] ^= l if l else 0xFF
+        self.s = permute_bytes(self.s, 12)
+        self.et = l
+
+
+class Motorist:
+    def __init__(self, width, rs, ra, key, nonce):
+        self.p = Piston(width, rs, ra)
+        keypack = bytes([40]) + key + b"\x01"
+        keypack += bytes(40 - len(keypack))
+        # The SUV, followed by the number of pistons and the index of this
+        # one, for the single-piston instances.
+        x = list(keypack + nonce + b"\x01\x00")
+        while True:
+            self.p.inject(x, False)
+            if not x:
+                break
+            self.p.spark(False, 0)
+        self.p.spark(True, 0)
+
+    def wrap(self, msg, ad, unwrap=False):
+        msg, ad, out = list(msg), list(ad), b""
+        while msg:
+            out += self.p.crypt(msg, unwrap)
+            self.p.inject(ad, True)
+            if msg or ad:
+                self.p.spark(False, 0)
+        while ad:
+            self.p.inject(ad, False)
+            if ad:
+                self.p.spark(False, 0)
+        self.p.spark(True, 16)
+        return out + bytes(self.p.s[:16])
+
+
+def lengths(rs, ra):
+    """The lengths of TestKeyakChained: around the two rates and two message
+    blocks."""
+    return sorted({0, 1, rs - 1, rs, rs + 1, ra - 1, ra, ra + 1, 2 * rs + 1})
+
+
+if __name__ == "__main__":
+    key, nonce = bytes(range(16)), bytes(range(0x40, 0x50))
+    for name, width, rs, ra in INSTANCES:
+        for adlen, msglen in [(0, 0), (3, 5), (300, 500)]:
+            print(name, adlen, msglen, short(Motorist(width, rs, ra, key, nonce).wrap(ptn(msglen), ptn(adlen))))
+
+        m = Motorist(width, rs, ra, key, nonce)
+        for msg, ad in [(ptn(10), b"ad1"), (b"", ptn(200)), (ptn(100), b"")]:
+            print(name, "session", short(m.wrap(msg, ad)))
+
+        check = hashlib.shake_128()
+        m = Motorist(width, rs, ra, key, nonce)
+        for adlen in lengths(rs, ra):
+            for msglen in lengths(rs, ra):
+                ct = m.wrap(ptn(msglen), ptn(adlen))
+                check.update(ct)
+        for keylen in range(16, 39):
+            ct = Motorist(width, rs, ra, ct[:keylen], ct[:16]).wrap(ptn(keylen), b"")
+            check.update(ct)
+        print(name, "chained", lengths(rs, ra), check.hexdigest(32))
@@ -0,0 +1,12 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the tags of TestKeyedKeccak256 in keyed_test.go: Keccak-256 of
+bytepad(encode_string(K), 136) || M, with K = 40 41 42 ... 5f."""
+
+from ref import bytepad, encode_string, keccak256, ptn
+
+key = bytes(range(0x40, 0x60))
+for msg in (b"", b"abc", ptn(300)):
+    print(len(msg), keccak256(bytepad(encode_string(key), 136) + msg).hex())
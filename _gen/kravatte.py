@@ -0,0 +1,188 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of kravatte_test.go.
+
+This is a transcription of Farfalle, from "Farfalle: parallel
+permutation-based cryptography", https://keccak.team/files/Farfalle.pdf,
+instantiated as Kravatte over Keccak-p[1600, 6] with the rolling functions
+of Kravatte Achouffe, https://keccak.team/files/KravatteAchouffe.pdf:
+
+- the key is padded with 10* to a block and permuted to give the mask k;
+- every input block is added to the mask, permuted and accumulated in x,
+  and the mask is rolled with roll_c after every block, and once more
+  after the last block of every string, which is padded with 10* after its
+  suffix bits;
+- the expansion permutes x to y, and every output block is y permuted
+  and added to the mask as left by the input, after which y is rolled with
+  roll_e.
+
+roll_c updates the last row of the state as a linear feedback shift
+register, and roll_e the last two rows as a non-linear one; both are
+written here on lanes, lane (x, y) at index x + 5y, as in the Kravatte
+Achouffe document.
+
+Kravatte-SANE and Kravatte-SANSE are the Deck-SANE and Deck-SANSE session
+modes of the Farfalle paper over Kravatte, with 128-bit tags; the
+keystream of SANE starts after the tag.
+
+The tests use the key 00 01 02 ... 1f and the nonce 40 41 42 ... 4f.
+TestKravatteChained compresses a string for every length of LENGTHS, and
+then every pair of them as a sequence of two strings, and expands 450
+bytes after each, so that the output mask is checked after any number of
+rolls from 2 to 10 and the expansion over three blocks.
+TestKravatteSessionChained seals, in one SANE and one SANSE session, a
+message for every pair of lengths. The SHAKE128 digests of the outputs are
+compared with the ones printed here.
+"""
+
+import hashlib
+
+from ref import keccak_p, ptn, short
+
+MASK = (1 << 64) - 1
+ROUNDS = 6
+LENGTHS = [0, 1, 198, 199, 200, 201, 399, 400, 401, 601]
+
+
+def rol(v, n):
+    return ((v << n) | (v >> (64 - n))) & MASK
+
+
+def roll_c(a):
+    x0, x1 = a[20], a[21]
+    return a[:20] + a[21:25] + [rol(x0, 7) ^ x1 ^ (x1 >> 3)]
+
+
+def roll_e(a):
+    x0, x1, x2 = a[15], a[16], a[17]
+    return a[:15] + a[16:25] + [rol(x0, 7) ^ rol(x1, 18) ^ (x2 & (x1 >> 1))]
+
+
+def to_lanes(b):
+    return [int.from_bytes(b[8 * i:8 * i + 8], "little") for i in range(25)]
+
+
+def to_bytes(a):
+    return b"".join(v.to_bytes(8, "little") for v in a)
+
+
+def xor(a, b):
+    return bytes(x ^ y for x, y in zip(a, b))
+
+
+class Kravatte:
+    def __init__(self, key):
+        k = bytearray(key) + b"\x01"
+        k += bytes(200 - len(k))
+        self.k = keccak_p(to_lanes(k), ROUNDS)
+        self.x = [0] * 25
+
+    def copy(self):
+        c = Kravatte.__new__(Kravatte)
+        c.k, c.x = list(self.k), list(self.x)
+        return c
+
+    def compress_block(self, block):
+        a = keccak_p([m ^ k for m, k in zip(to_lanes(block), self.k)], ROUNDS)
+        self.x = [x ^ v for x, v in zip(self.x, a)]
+        self.k = roll_c(self.k)
+
+    def add_string(self, m, suffix=0, suffix_bits=0):
+        """Compresses the string m followed by the suffix_bits low bits of
+        suffix, with the 10* padding."""
+        while len(m) >= 200:
+            self.compress_block(m[:200])
+            m = m[200:]
+        block = bytearray(200)
+        block[:len(m)] = m
+        block[len(m)] = suffix | 1 << suffix_bits
+        self.compress_block(bytes(block))
+        self.k = roll_c(self.k)
+
+    def expand(self, n, offset=0):
+        y = keccak_p(self.x, ROUNDS)
+        out = b""
+        while len(out) < offset + n:
+            out += to_bytes([z ^ k for z, k in zip(keccak_p(y, ROUNDS), self.k)])
+            y = roll_e(y)
+        return out[offset:offset + n]
+
+
+class SANE:
+    def __init__(self, key, nonce):
+        self.f = Kravatte(key)
+        self.f.add_string(nonce)
+        self.e = 0
+        self.tag = self.f.expand(16)
+
+    def seal(self, msg, ad):
+        ct = xor(msg, self.f.expand(len(msg), 16))
+        if ad or not msg:
+            self.f.add_string(ad, self.e << 1, 2)
+        if msg:
+            self.f.add_string(ct, 1 | self.e << 1, 2)
+        self.tag = self.f.expand(16)
+        self.e ^= 1
+        return ct + self.tag
+
+
+class SANSE:
+    def __init__(self, key):
+        self.f = Kravatte(key)
+        self.e = 0
+
+    def seal(self, msg, ad):
+        if ad or not msg:
+            self.f.add_string(ad, self.e << 1, 2)
+        if not msg:
+            self.e ^= 1
+            return self.f.expand(16)
+        g = self.f.copy()
+        g.add_string(msg, 0b10 | self.e << 2, 3)
+        tag = g.expand(16)
+        h = self.f.copy()
+        h.add_string(tag, 0b11 | self.e << 2, 3)
+        ct = xor(msg, h.expand(len(msg)))
+        self.f = g
+        self.e ^= 1
+        return ct + tag
+
+
+if __name__ == "__main__":
+    key, nonce = bytes(range(32)), bytes(range(0x40, 0x50))
+
+    f = Kravatte(key)
+    print("empty", f.expand(16).hex())
+    f.add_string(ptn(500))
+    print("ptn(500)", short(f.expand(400)))
+
+    messages = [(ptn(10), b"ad1"), (b"", ptn(300)), (ptn(500), b""), (b"", b"")]
+    s = SANE(key, nonce)
+    print("SANE initial tag", s.tag.hex())
+    for msg, ad in messages:
+        print("SANE", short(s.seal(msg, ad)))
+    s = SANSE(key)
+    for msg, ad in messages:
+        print("SANSE", short(s.seal(msg, ad)))
+
+    check = hashlib.shake_128()
+    for a in LENGTHS:
+        f = Kravatte(key)
+        f.add_string(ptn(a))
+        check.update(f.expand(450))
+    for a in LENGTHS:
+        for b in LENGTHS:
+            f = Kravatte(key)
+            f.add_string(ptn(a))
+            f.add_string(ptn(b), 0b1, 1)
+            check.update(f.expand(450))
+    print("chained Kravatte", check.hexdigest(32))
+
+    for name, s in [("SANE", SANE(key, nonce)), ("SANSE", SANSE(key))]:
+        check = hashlib.shake_128()
+        for a in LENGTHS:
+            for b in LENGTHS:
+                check.update(s.seal(ptn(b), ptn(a)))
+        print("chained", name, check.hexdigest(32))
@@ -0,0 +1,15 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of TestLiteVectors in lite_test.go.
+
+Lite-256 and LiteSHAKE128 are the SHA3-256 and SHAKE128 padding over
+Keccak-f[800], with a rate of 68 bytes.
+"""
+
+from ref import ptn, sponge
+
+for msg in (b"", b"abc", ptn(200)):
+    print(len(msg), sponge(68, 0x06, msg, 32, rounds=22, width=800).hex(),
+          sponge(68, 0x1F, msg, 64, rounds=22, width=800).hex())
@@ -0,0 +1,17 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the outputs of TestNarrowSponges in narrow_test.go: sponges over
+Keccak-f[800], Keccak-f[400] and Keccak-f[200], with the legacy Keccak
+padding."""
+
+from ref import ptn, sponge
+
+for width, rounds, rate, outlen, msg in [
+    (800, 22, 68, 32, b"abc"),
+    (400, 20, 18, 32, b"abc"),
+    (200, 18, 5, 32, b"abc"),
+    (800, 22, 68, 100, ptn(200)),
+]:
+    print(width, rate, len(msg), sponge(rate, 0x01, msg, outlen, rounds, width).hex())
@@ -0,0 +1,11 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the states of TestKeccakP1600 in permutation_test.go:
+Keccak-p[1600, 12] and Keccak-p[1600, 14] applied to the zero state."""
+
+from ref import keccak_p
+
+for rounds in (12, 14):
+    print(rounds, ", ".join("0x%016X" % v for v in keccak_p([0] * 25, rounds)))
@@ -0,0 +1,12 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of TestReducedRoundsVectors in reducedrounds_test.go:
+Keccak-256 and SHAKE128 of "abc" over the last rounds of Keccak-f[1600]."""
+
+from ref import sponge
+
+print("Keccak-256 4", sponge(136, 0x01, b"abc", 32, rounds=4).hex())
+print("Keccak-256 8", sponge(136, 0x01, b"abc", 32, rounds=8).hex())
+print("SHAKE128 4", sponge(168, 0x1F, b"abc", 32, rounds=4).hex())
@@ -0,0 +1,217 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Reference implementations used to generate the test vectors of this
+package for the constructions that have no published ones.
+
+The code follows the specifications as literally as possible: the
+Keccak-p[b, nr] permutation of FIPS 202, section 3, on lists of lanes; the
+sponge and duplex constructions of "Cryptographic sponge functions" and
+"Duplexing the sponge"; and the encodings of NIST SP 800-185. It shares no
+code with the Go package, and favors clarity over speed.
+
+Running this file checks the permutation and the sponge against the SHA-3
+and SHAKE functions of Python's hashlib. The other scripts in this
+directory print the vectors of one test file each, and say which.
+"""
+
+import hashlib
+
+# Round constants of Keccak-p[1600], from which those of the narrower
+# permutations are truncated.
+RC = [
+    0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+    0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+    0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+    0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+    0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+    0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+]
+
+# Rotation offsets of ρ, indexed [x][y].
+ROT = [
+    [0, 36, 3, 41, 18],
+    [1, 44, 10, 45, 2],
+    [62, 6, 43, 15, 61],
+    [28, 55, 25, 21, 56],
+    [27, 20, 39, 8, 14],
+]
+
+
+def keccak_p(lanes, rounds=24, width=1600):
+    """Applies Keccak-p[width, rounds] to 25 lanes, lane (x, y) at index
+    x + 5y, and returns the new lanes. As in FIPS 202, the rounds are the
+    last ones of Keccak-f[width]."""
+    w = width // 25
+    mask = (1 << w) - 1
+    total = 12 + 2 * (w.bit_length() - 1)
+
+    def rol(v, n):
+        n %= w
+        return ((v << n) | (v >> (w - n))) & mask if n else v
+
+    a = list(lanes)
+    for ir in range(total - rounds, total):
+        # θ
+        c = [a[x] ^ a[x + 5] ^ a[x + 10] ^ a[x + 15] ^ a[x + 20] for x in range(5)]
+        d = [c[(x - 1) % 5] ^ rol(c[(x + 1) % 5], 1) for x in range(5)]
+        a = [a[i] ^ d[i % 5] for i in range(25)]
+        # ρ and π
+        b = [0] * 25
+        for x in range(5):
+            for y in range(5):
+                b[y + 5 * ((2 * x + 3 * y) % 5)] = rol(a[x + 5 * y], ROT[x][y])
+        # χ
+        a = [b[i] ^ (~b[(i + 1) % 5 + i // 5 * 5] & b[(i + 2) % 5 + i // 5 * 5]) & mask
+             for i in range(25)]
+        # ι
+        a[0] ^= RC[ir] & mask
+    return a
+
+
+def permute_bytes(state, rounds=24):
+    """Applies Keccak-p[8*len(state), rounds] to a state given as bytes,
+    lanes in little-endian order, and returns it as a bytearray."""
+    lb = len(state) // 25
+    lanes = [int.from_bytes(state[i * lb:(i + 1) * lb], "little") for i in range(25)]
+    lanes = keccak_p(lanes, rounds, 8 * len(state))
+    return bytearray(b"".join(v.to_bytes(lb, "little") for v in lanes))
+
+
+def pad(msg, rate, ds):
+    """Appends the delimited suffix ds and the pad10*1 padding to msg, up to
+    a multiple of rate bytes."""
+    m = bytearray(msg) + bytes([ds])
+    m += bytes(-len(m) % rate)
+    m[-1] |= 0x80
+    return bytes(m)
+
+
+def sponge(rate, ds, msg, outlen, rounds=24, width=1600):
+    """Returns outlen bytes of the sponge over Keccak-p[width, rounds] with
+    a rate of rate bytes, absorbing msg followed by the delimited suffix
+    ds."""
+    s = bytearray(width // 8)
+    m = pad(msg, rate, ds)
+    for i in range(0, len(m), rate):
+        for j in range(rate):
+            s[j] ^= m[i + j]
+        s = permute_bytes(s, rounds)
+    out = b""
+    while True:
+        out += bytes(s[:rate])
+        if len(out) >= outlen:
+            return out[:outlen]
+        s = permute_bytes(s, rounds)
+
+
+class Duplex:
+    """The duplex construction over Keccak-f[1600]."""
+
+    def __init__(self, rate):
+        self.rate = rate
+        self.s = bytearray(200)
+
+    def duplexing(self, sigma, l, ds=0x01):
+        """Absorbs sigma followed by the delimited suffix ds, permutes and
+        returns the first l bytes of the state."""
+        m = pad(sigma, self.rate, ds)
+        assert len(m) == self.rate, "input too long for one duplexing call"
+        for j in range(self.rate):
+            self.s[j] ^= m[j]
+        self.s = permute_bytes(self.s)
+        return bytes(self.s[:l])
+
+
+def ptn(n):
+    """Returns the test pattern 00 01 02 ... fa 00 01 ... of n bytes, as in
+    the KangarooTwelve specification."""
+    return bytes(i % 251 for i in range(n))
+
+
+def keccak256(data):
+    return sponge(136, 0x01, data, 32)
+
+
+def short(data):
+    """Returns data in hex if it is at most 24 bytes long, and its
+    Keccak-256 digest otherwise, the form the tests use for long outputs."""
+    return (data if len(data) <= 24 else keccak256(data)).hex()
+
+
+# NIST SP 800-185, section 2.3.
+
+
+def left_encode(x):
+    n = max(1, (x.bit_length() + 7) // 8)
+    return bytes([n]) + x.to_bytes(n, "big")
+
+
+def right_encode(x):
+    n = max(1, (x.bit_length() + 7) // 8)
+    return x.to_bytes(n, "big") + bytes([n])
+
+
+def encode_string(s):
+    return left_encode(8 * len(s)) + s
+
+
+def bytepad(x, w):
+    z = left_encode(w) + x
+    return z + bytes(-len(z) % w)
+
+
+def cshake(bits, x, outlen, name=b"", custom=b""):
+    rate = 200 - bits // 4
+    if not name and not custom:
+        return sponge(rate, 0x1F, x, outlen)
+    return sponge(rate, 0x04, bytepad(encode_string(name) + encode_string(custom), rate) + x, outlen)
+
+
+def kmac(bits, key, x, outlen, custom=b"", xof=False):
+    rate = 200 - bits // 4
+    x = bytepad(encode_string(key), rate) + x + right_encode(0 if xof else 8 * outlen)
+    return cshake(bits, x, outlen, b"KMAC", custom)
+
+
+def tuplehash(bits, tup, outlen, custom=b"", xof=False):
+    x = b"".join(encode_string(s) for s in tup) + right_encode(0 if xof else 8 * outlen)
+    return cshake(bits, x, outlen, b"TupleHash", custom)
+
+
+# The Sakura tree hashing of KangarooTwelve (RFC 9861), parameterized so
+# that it also describes MarsupilamiFourteen and the Sakura SHAKE trees.
+
+
+def length_encode(x):
+    b = x.to_bytes((x.bit_length() + 7) // 8, "big")
+    return b + bytes([len(b)])
+
+
+def sakura_tree(msg, outlen, rate, cvlen, rounds, chunk, ds_single, ds_final, ds_leaf):
+    """Hashes msg as a final node holding the first chunk and the chaining
+    values of the other chunks, each the hash of a leaf, or as a single
+    node if it fits in one chunk."""
+    if len(msg) <= chunk:
+        return sponge(rate, ds_single, msg, outlen, rounds)
+    node = msg[:chunk] + b"\x03" + bytes(7)
+    n = 0
+    for i in range(chunk, len(msg), chunk):
+        node += sponge(rate, ds_leaf, msg[i:i + chunk], cvlen, rounds)
+        n += 1
+    node += length_encode(n) + b"\xff\xff"
+    return sponge(rate, ds_final, node, outlen, rounds)
+
+
+if __name__ == "__main__":
+    for n in (0, 3, 135, 136, 137, 500):
+        m = ptn(n)
+        assert sponge(144, 0x06, m, 28) == hashlib.sha3_224(m).digest()
+        assert sponge(136, 0x06, m, 32) == hashlib.sha3_256(m).digest()
+        assert sponge(104, 0x06, m, 48) == hashlib.sha3_384(m).digest()
+        assert sponge(72, 0x06, m, 64) == hashlib.sha3_512(m).digest()
+        assert sponge(168, 0x1F, m, 500) == hashlib.shake_128(m).digest(500)
+        assert sponge(136, 0x1F, m, 300) == hashlib.shake_256(m).digest(300)
+    assert keccak256(b"").hex() == "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+    print("ok")
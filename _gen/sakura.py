@@ -0,0 +1,18 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of TestSakuraShakeVectors in sakura_test.go.
+
+The Sakura SHAKE trees hash with kangaroo hopping over RawSHAKE, so the
+suffixes of the nodes are the Sakura frame bits followed by '11': 0x1f for
+a single node, 0x1e for a final node ending with chaining values and 0x3b
+for a leaf. Chaining values are 32 bytes long for SHAKE128 and 64 for
+SHAKE256.
+"""
+
+from ref import ptn, sakura_tree
+
+for name, rate, cvlen in [("128", 168, 32), ("256", 136, 64)]:
+    for n, chunk in [(0, 1024), (1024, 1024), (1025, 1024), (5000, 1024), (20000, 8192)]:
+        print(name, n, chunk, sakura_tree(ptn(n), cvlen, rate, cvlen, 24, chunk, 0x1F, 0x1E, 0x3B).hex())
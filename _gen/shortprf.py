@@ -0,0 +1,17 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of TestShortPRFVectors in shortprf_test.go.
+
+ShortPRF is 16 bytes of TurboSHAKE128 (RFC 9861) of the key followed by
+the message, with the domain separation byte 0x0c.
+"""
+
+from ref import sponge
+
+key = bytes(range(16))
+# 152 is the shortest message that does not fit in one block with the key.
+for n in (0, 15, 64, 152, 200):
+    msg = bytes(i % 256 for i in range(n))
+    print(n, sponge(168, 0x0C, key + msg, 16, rounds=12).hex())
@@ -0,0 +1,15 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the outputs of TestNewCustom in sponge_test.go and
+TestLegacyKeccakXOF in keccak_test.go."""
+
+from ref import sponge
+
+# Keccak[r=800, c=800] with the delimited suffix 0x02.
+print("New(100, 100, 0x02, 48)", sponge(100, 0x02, b"abc", 48).hex())
+
+# The legacy Keccak padding with outputs longer than the digests.
+print("XOF256", sponge(136, 0x01, b"abc", 300)[-32:].hex())
+print("XOF512", sponge(72, 0x01, b"abc", 200)[-32:].hex())
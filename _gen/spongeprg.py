@@ -0,0 +1,53 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the outputs of TestSpongePRG in spongeprg_test.go.
+
+SpongePRG is the reseedable generator of "Sponge-based pseudo-random
+number generators", section 4, over the duplex construction of
+Keccak-f[1600] with a rate of 168 bytes and blocks of 167 bytes.
+"""
+
+from ref import Duplex, ptn
+
+BLOCK = 167
+
+
+class SpongePRG:
+    def __init__(self, seed):
+        self.d = Duplex(168)
+        self.pending = b""
+        self.out = b""
+        self.fed = False
+        self.feed(seed)
+
+    def feed(self, x):
+        self.pending += x
+        self.out = b""
+        self.fed = True
+        while len(self.pending) >= BLOCK:
+            self.d.duplexing(self.pending[:BLOCK], 0)
+            self.pending = self.pending[BLOCK:]
+
+    def fetch(self, n):
+        if self.fed:
+            self.out = self.d.duplexing(self.pending, BLOCK)
+            self.pending = b""
+            self.fed = False
+        r = b""
+        while len(r) < n:
+            if not self.out:
+                self.out = self.d.duplexing(b"", BLOCK)
+            k = min(n - len(r), len(self.out))
+            r += self.out[:k]
+            self.out = self.out[k:]
+        return r
+
+
+p = SpongePRG(b"seed")
+print("first output", p.fetch(16).hex())
+print("end of second output", p.fetch(400)[384:].hex())
+p.feed(ptn(200))
+print("output after reseeding", p.fetch(8).hex())
+print("Uint64", int.from_bytes(p.fetch(8), "little"))
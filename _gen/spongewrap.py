@@ -0,0 +1,52 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the vectors of TestSpongeWrapVectors in spongewrap_test.go.
+
+SpongeWrap is the authenticated encryption mode of "Duplexing the sponge",
+section 6.1, over the duplex construction of Keccak-f[1600] with a rate of
+168 bytes. Blocks are 167 bytes long, and each is followed by a frame bit,
+which with the first bit of the padding makes up the delimited suffix. As
+in the paper, the blocks of the associated data have the frame bit 0 but
+the last, and those of the message 1 but the last; the key and the nonce,
+absorbed first, have the frame bit 1 but the last.
+"""
+
+from ref import Duplex, ptn, short
+
+BLOCK = 167
+
+
+def blocks(x):
+    return [x[i:i + BLOCK] for i in range(0, len(x), BLOCK)] or [b""]
+
+
+def xor(a, b):
+    return bytes(x ^ y for x, y in zip(a, b))
+
+
+def wrap(key, nonce, ad, msg, taglen):
+    d = Duplex(168)
+    k = blocks(key + nonce)
+    for b in k[:-1]:
+        d.duplexing(b, 0, 0x03)
+    d.duplexing(k[-1], 0, 0x02)
+    a = blocks(ad)
+    for b in a[:-1]:
+        d.duplexing(b, 0, 0x02)
+    m = blocks(msg)
+    z = d.duplexing(a[-1], len(m[0]), 0x03)
+    ct = xor(m[0], z)
+    for i in range(len(m) - 1):
+        z = d.duplexing(m[i], len(m[i + 1]), 0x03)
+        ct += xor(m[i + 1], z)
+    tag = d.duplexing(m[-1], BLOCK, 0x02)
+    while len(tag) < taglen:
+        tag += d.duplexing(b"", BLOCK, 0x02)
+    return ct + tag[:taglen]
+
+
+key, nonce = bytes(range(16)), bytes(range(16, 32))
+for adlen, msglen, taglen in [(0, 0, 16), (3, 5, 16), (200, 500, 32), (167, 334, 64), (0, 168, 12)]:
+    print(adlen, msglen, taglen, short(wrap(key, nonce, ptn(adlen), ptn(msglen), taglen)))
@@ -0,0 +1,125 @@
+# Copyright 2024 The go-keccak Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""Prints the outputs of TestStrobeSession in strobe_test.go.
+
+This is a transcription of the STROBE v1.0.2 specification,
+https://strobe.sourceforge.io/specs/, over Keccak-f[1600]. Running it also
+checks it against the Merlin conformance vector of TestStrobeMerlin.
+"""
+
+from ref import permute_bytes
+
+I, A, C, T, M, K = 1, 2, 4, 8, 16, 32
+
+
+class Strobe:
+    def __init__(self, proto, sec=128):
+        self.r = 200 - sec // 4 - 2
+        domain = bytes([1, self.r + 2, 1, 0, 1, 96]) + b"STROBEv1.0.2"
+        st = bytearray(200)
+        st[:len(domain)] = domain
+        self.st = permute_bytes(st)
+        self.pos = self.begin = 0
+        self.i0 = None
+        self.cur = None
+        self.op(A | M, proto)
+
+    def run_f(self):
+        self.st[self.pos] ^= self.begin
+        self.st[self.pos + 1] ^= 0x04
+        self.st[self.r + 1] ^= 0x80
+        self.st = permute_bytes(self.st)
+        self.pos = self.begin = 0
+
+    def duplex(self, data, cbefore=False, cafter=False, force_f=False):
+        data = bytearray(data)
+        for i in range(len(data)):
+            if cbefore:
+                data[i] ^= self.st[self.pos]
+            self.st[self.pos] ^= data[i]
+            if cafter:
+                data[i] = self.st[self.pos]
+            self.pos += 1
+            if self.pos == self.r:
+                self.run_f()
+        if force_f and self.pos != 0:
+            self.run_f()
+        return bytes(data)
+
+    def begin_op(self, flags):
+        if flags & T:
+            if self.i0 is None:
+                self.i0 = flags & I
+            flags ^= self.i0
+        old, self.begin = self.begin, self.pos + 1
+        self.duplex([old, flags], force_f=bool(flags & (C | K)))
+
+    def op(self, flags, data, more=False):
+        """Runs the operation flags on data, which for the operations that
+        produce output without input is the length of the output. Returns
+        the output, or for the MAC checks whether the MAC matched."""
+        if more:
+            assert flags == self.cur
+        else:
+            self.begin_op(flags)
+            self.cur = flags
+        if isinstance(data, int):
+            data = bytes(data)
+        cafter = flags & (C | I | T) == (C | T)
+        cbefore = bool(flags & C) and not cafter
+        out = self.duplex(data, cbefore, cafter)
+        if flags & (I | A | T) == (I | T):
+            return not any(out)
+        return out
+
+
+def merlin_append(s, label, msg):
+    s.op(A | M, label)
+    s.op(A | M, len(msg).to_bytes(4, "little"), True)
+    s.op(A, msg)
+
+
+def merlin_challenge(s, label, n):
+    s.op(A | M, label)
+    s.op(A | M, n.to_bytes(4, "little"), True)
+    return s.op(I | A | C, n)
+
+
+s = Strobe(b"Merlin v1.0")
+merlin_append(s, b"dom-sep", b"test protocol")
+merlin_append(s, b"some label", b"some data")
+assert merlin_challenge(s, b"challenge", 32).hex() == \
+    "d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615"
+
+for sec in (128, 256):
+    a, b = Strobe(b"test", sec), Strobe(b"test", sec)
+    for s in (a, b):
+        s.op(A, b"hello")
+        s.op(A, b" world", True)
+        s.op(A | C, bytes(range(32)))
+    prf = a.op(I | A | C, 16)
+    b.op(I | A | C, 16)
+    print(sec, "PRF", prf.hex())
+
+    ct = a.op(A | C | T, b"attack at dawn")
+    assert b.op(I | A | C | T, ct) == b"attack at dawn"
+    print(sec, "SendENC", ct.hex())
+
+    mac = a.op(C | T, 16)
+    assert b.op(I | C | T, mac)
+    print(sec, "SendMAC", mac.hex())
+
+    a.op(C, 32)
+    b.op(C, 32)
+
+    # The roles swap: b sends and a receives.
+    a.op(I | A | T, b.op(A | T, b"clear"))
+    ct2 = b.op(A | C | T, 300)
+    assert a.op(I | A | C | T, ct2) == bytes(300)
+    print(sec, "second SendENC", ct2[:32].hex())
+
+    prf2 = a.op(I | A | C, 32)
+    assert b.op(I | A | C, 32) == prf2
+    print(sec, "final PRF", prf2.hex())
@@ -0,0 +1,146 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ABI is the functions, events and errors of a contract ABI.
+type ABI []ABIEntry
+
+// ABIEntry is a function, event or error of a contract ABI, with the
+// identifiers derived from its signature.
+type ABIEntry struct {
+	// Type is "function", "event" or "error".
+	Type string
+
+	// Name is the name of the function, event or error, and Signature its
+	// canonical signature, as CanonicalSignature returns it.
+	Name      string
+	Signature string
+
+	// Topic is the Keccak-256 digest of the signature, and Selector its
+	// first 4 bytes. Events are identified by their topic, and functions
+	// and errors by their selector.
+	Topic    [32]byte
+	Selector [4]byte
+
+	// Anonymous is set for anonymous events, whose logs do not start with
+	// their topic.
+	Anonymous bool
+}
+
+// abiEntry and abiParam are the JSON encoding of the entries of an ABI
+// and of their parameters.
+type abiEntry struct {
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	Inputs    []abiParam `json:"inputs"`
+	Anonymous bool       `json:"anonymous"`
+}
+
+type abiParam struct {
+	Type       string     `json:"type"`
+	Components []abiParam `json:"components"`
+}
+
+// ParseABI parses a contract ABI in the JSON format of the Solidity
+// compiler, or a build artifact with the ABI in its "abi" field, and
+// returns its functions, events and errors in order. Constructors, fallback
+// and receive functions have no signature and are skipped.
+func ParseABI(data []byte) (ABI, error) {
+	var entries []abiEntry
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var artifact struct {
+			ABI []abiEntry `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			return nil, fmt.Errorf("keccak: invalid ABI: %w", err)
+		}
+		entries = artifact.ABI
+	} else if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("keccak: invalid ABI: %w", err)
+	}
+
+	var abi ABI
+	for _, e := range entries {
+		switch e.Type {
+		case "":
+			// The type of an entry defaults to function.
+			e.Type = "function"
+		case "function", "event", "error":
+		case "constructor", "fallback", "receive":
+			continue
+		default:
+			return nil, fmt.Errorf("keccak: invalid ABI: unknown entry type %q", e.Type)
+		}
+		params, err := abiParams(e.Inputs)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := CanonicalSignature(e.Name + params)
+		if err != nil {
+			return nil, err
+		}
+		topic := Sum256([]byte(sig))
+		abi = append(abi, ABIEntry{
+			Type:      e.Type,
+			Name:      e.Name,
+			Signature: sig,
+			Topic:     topic,
+			Selector:  [4]byte(topic[:]),
+			Anonymous: e.Type == "event" && e.Anonymous,
+		})
+	}
+	return abi, nil
+}
+
+// abiParams returns the types of params as a parameter list, with tuples
+// written as their components in parentheses. The types themselves are
+// checked by CanonicalSignature, but abiParams rejects those that it would
+// parse as a type followed by a name.
+func abiParams(params []abiParam) (string, error) {
+	types := make([]string, len(params))
+	for i, p := range params {
+		for j := range len(p.Type) {
+			if c := p.Type[j]; !isIdentifierByte(c) && c != '[' && c != ']' {
+				return "", fmt.Errorf("keccak: invalid ABI: invalid type %q", p.Type)
+			}
+		}
+		types[i] = p.Type
+		suffix, ok := strings.CutPrefix(p.Type, "tuple")
+		if !ok {
+			continue
+		}
+		if suffix != "" && suffix[0] != '[' {
+			return "", fmt.Errorf("keccak: invalid ABI: invalid type %q", p.Type)
+		}
+		components, err := abiParams(p.Components)
+		if err != nil {
+			return "", err
+		}
+		types[i] = components + suffix
+	}
+	return "(" + strings.Join(types, ",") + ")", nil
+}
+
+// InterfaceID returns the ERC-165 identifier of the functions of a, as
+// InterfaceID does for their signatures. a should be the ABI of the
+// interface alone, without the functions it inherits.
+func (a ABI) InterfaceID() (id [4]byte) {
+	for _, e := range a {
+		if e.Type != "function" {
+			continue
+		}
+		for i := range id {
+			id[i] ^= e.Selector[i]
+		}
+	}
+	return
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+const testABI = `[
+	{"type": "constructor", "inputs": [{"name": "supply", "type": "uint256"}]},
+	{"type": "function", "name": "transfer", "stateMutability": "nonpayable",
+	 "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}],
+	 "outputs": [{"name": "", "type": "bool"}]},
+	{"name": "balanceOf", "inputs": [{"name": "owner", "type": "address"}]},
+	{"type": "event", "name": "Transfer", "anonymous": false, "inputs": [
+		{"name": "from", "type": "address", "indexed": true},
+		{"name": "to", "type": "address", "indexed": true},
+		{"name": "value", "type": "uint256", "indexed": false}]},
+	{"type": "error", "name": "Panic", "inputs": [{"name": "code", "type": "uint256"}]},
+	{"type": "function", "name": "submit", "inputs": [
+		{"name": "orders", "type": "tuple[]", "components": [
+			{"name": "maker", "type": "address"},
+			{"name": "legs", "type": "tuple[2]", "components": [
+				{"name": "amount", "type": "uint128"},
+				{"name": "data", "type": "bytes"}]}]},
+		{"name": "ids", "type": "uint256[3][]"}]},
+	{"type": "event", "name": "Ping", "anonymous": true, "inputs": []},
+	{"type": "fallback"},
+	{"type": "receive", "stateMutability": "payable"}
+]`
+
+func TestParseABI(t *testing.T) {
+	for _, data := range []string{testABI, `{"contractName": "Token", "abi": ` + testABI + `}`} {
+		abi, err := ParseABI([]byte(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []struct {
+			typ, sig, selector string
+			anonymous          bool
+		}{
+			{"function", "transfer(address,uint256)", "a9059cbb", false},
+			{"function", "balanceOf(address)", "70a08231", false},
+			{"event", "Transfer(address,address,uint256)", "ddf252ad", false},
+			{"error", "Panic(uint256)", "4e487b71", false},
+			{"function", "submit((address,(uint128,bytes)[2])[],uint256[3][])", "", false},
+			{"event", "Ping()", "", true},
+		}
+		if len(abi) != len(want) {
+			t.Fatalf("ParseABI returned %d entries, want %d", len(abi), len(want))
+		}
+		for i, w := range want {
+			e := abi[i]
+			if e.Type != w.typ || e.Signature != w.sig || e.Anonymous != w.anonymous {
+				t.Errorf("entry %d = %s %s (anonymous %v), want %s %s (anonymous %v)", i, e.Type, e.Signature, e.Anonymous, w.typ, w.sig, w.anonymous)
+			}
+			if e.Topic != EventTopic(w.sig) || e.Selector != Selector(w.sig) {
+				t.Errorf("entry %d: topic %x and selector %x do not match %s", i, e.Topic, e.Selector, w.sig)
+			}
+			if w.selector != "" && hex.EncodeToString(e.Selector[:]) != w.selector {
+				t.Errorf("entry %d: selector %x, want %s", i, e.Selector, w.selector)
+			}
+		}
+	}
+}
+
+func TestParseABIInterfaceID(t *testing.T) {
+	abi, err := ParseABI([]byte(`[{"type": "function", "name": "supportsInterface",
+		"inputs": [{"name": "interfaceId", "type": "bytes4"}], "outputs": [{"type": "bool"}]},
+		{"type": "event", "name": "E", "inputs": []}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := abi.InterfaceID(); hex.EncodeToString(got[:]) != "01ffc9a7" {
+		t.Errorf("InterfaceID = %x, want 01ffc9a7", got)
+	}
+}
+
+func TestParseABIErrors(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`{`,
+		`[{"type": "function", "name": "f", "inputs": 1}]`,
+		`[{"type": "method", "name": "f", "inputs": []}]`,
+		`[{"type": "function", "inputs": []}]`,
+		`[{"type": "function", "name": "f", "inputs": [{"type": "uint7"}]}]`,
+		`[{"type": "function", "name": "f", "inputs": [{"type": "tuplex", "components": []}]}]`,
+		`[{"type": "function", "name": "f", "inputs": [{"type": "uint256 x"}]}]`,
+		`[{"type": "function", "name": "f", "inputs": [{"type": "(uint256)"}]}]`,
+		`[{"type": "function", "name": "f", "inputs": [{"type": "tuple", "components": [{"type": "bool,bool"}]}]}]`,
+	} {
+		if abi, err := ParseABI([]byte(data)); err == nil {
+			t.Errorf("ParseABI(%s) = %v, want an error", data, abi)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file derives Ethereum addresses, which are the last 20 bytes of
+// Keccak-256 digests, so that wallet and explorer code does not need
+// go-ethereum for them.
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"math/bits"
+	"strconv"
+)
+
+// AddressLength is the size of an Ethereum address in bytes.
+const AddressLength = 20
+
+var (
+	// ErrAddressChecksum is returned by ValidateChecksum and
+	// ValidateChecksumForChain for a well-formed address whose case does
+	// not match its checksum, including an address in a single case.
+	ErrAddressChecksum = errors.New("keccak: address checksum mismatch")
+
+	errAddressFormat = errors.New("keccak: address is not 0x followed by 40 hexadecimal digits")
+)
+
+// PubkeyToAddress returns the Ethereum address of a secp256k1 public key:
+// the last 20 bytes of the Keccak-256 digest of its uncompressed encoding
+// without the 0x04 prefix, which is the two 32-byte big-endian coordinates.
+//
+// The coordinates are padded with leading zeros to 32 bytes, as the
+// encoding requires, rather than hashed at their minimal length. Only X and
+// Y are used, so the key may come from any implementation of the curve.
+// PubkeyToAddress panics if a coordinate is missing, negative or longer
+// than 32 bytes, since no point of secp256k1 has such coordinates.
+func PubkeyToAddress(pub ecdsa.PublicKey) (addr [AddressLength]byte) {
+	if pub.X == nil || pub.Y == nil || pub.X.Sign() < 0 || pub.Y.Sign() < 0 ||
+		pub.X.BitLen() > 256 || pub.Y.BitLen() > 256 {
+		panic("keccak: PubkeyToAddress of an invalid secp256k1 public key")
+	}
+	var point [64]byte
+	pub.X.FillBytes(point[:32])
+	pub.Y.FillBytes(point[32:])
+	digest := Keccak256Of64(point)
+	copy(addr[:], digest[12:])
+	return
+}
+
+// ChecksumAddress returns addr in hexadecimal with a 0x prefix and the
+// EIP-55 checksum: each letter is uppercase if the corresponding 4 bits of
+// the Keccak-256 digest of the lowercase address are at least 8.
+func ChecksumAddress(addr [AddressLength]byte) string {
+	return checksumAddress(addr, 0, false)
+}
+
+// ChecksumAddressForChain returns addr with the EIP-1191 checksum for the
+// given chain, which hashes the chain ID in decimal before the lowercase
+// address with its 0x prefix, so that an address checksummed for one chain
+// does not validate on another. Chains that have not adopted EIP-1191,
+// including Ethereum, use ChecksumAddress.
+func ChecksumAddressForChain(addr [AddressLength]byte, chainID uint64) string {
+	return checksumAddress(addr, chainID, true)
+}
+
+func checksumAddress(addr [AddressLength]byte, chainID uint64, withChain bool) string {
+	var buf [2 + 2*AddressLength]byte
+	copy(buf[:], "0x")
+	hex.Encode(buf[2:], addr[:])
+
+	d := state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	if withChain {
+		var id [20]byte
+		d.Write(strconv.AppendUint(id[:0], chainID, 10))
+		d.Write(buf[:])
+	} else {
+		d.Write(buf[2:])
+	}
+	var digest [32]byte
+	d.Read(digest[:])
+
+	for i, c := range buf[2:] {
+		nibble := digest[i/2] >> (4 - 4*(i%2)) & 0xf
+		if c >= 'a' && nibble >= 8 {
+			buf[2+i] = c - 'a' + 'A'
+		}
+	}
+	return string(buf[:])
+}
+
+// ValidateChecksum checks that s is an address with a valid EIP-55
+// checksum, as returned by ChecksumAddress. It returns ErrAddressChecksum
+// if s is a well-formed address with the wrong case, and another error if
+// s is not 0x followed by 40 hexadecimal digits.
+func ValidateChecksum(s string) error {
+	return validateChecksum(s, 0, false)
+}
+
+// ValidateChecksumForChain is ValidateChecksum for the EIP-1191 checksum
+// of the given chain, as returned by ChecksumAddressForChain.
+func ValidateChecksumForChain(s string, chainID uint64) error {
+	return validateChecksum(s, chainID, true)
+}
+
+func validateChecksum(s string, chainID uint64, withChain bool) error {
+	var addr [AddressLength]byte
+	if len(s) != 2+2*AddressLength || s[:2] != "0x" {
+		return errAddressFormat
+	}
+	if _, err := hex.Decode(addr[:], []byte(s[2:])); err != nil {
+		return errAddressFormat
+	}
+	if checksumAddress(addr, chainID, withChain) != s {
+		return ErrAddressChecksum
+	}
+	return nil
+}
+
+// CreateAddress returns the address of a contract created by sender with
+// the CREATE opcode or a contract creation transaction, from the nonce of
+// sender at the time: the last 20 bytes of the Keccak-256 digest of the
+// RLP encoding of the list of sender and nonce.
+func CreateAddress(sender [AddressLength]byte, nonce uint64) (addr [AddressLength]byte) {
+	// The list is at most 1 + 21 + 9 bytes long, so its header is a single
+	// byte, as is that of the sender, a 20-byte string.
+	var buf [1 + 1 + AddressLength + 9]byte
+	buf[1] = 0x80 + AddressLength
+	copy(buf[2:], sender[:])
+	n := 2 + AddressLength + rlpUint(buf[2+AddressLength:], nonce)
+	buf[0] = 0xc0 + byte(n-1)
+	digest := Sum256(buf[:n])
+	copy(addr[:], digest[12:])
+	return
+}
+
+// rlpUint writes the RLP encoding of x to b, which must have room for 9
+// bytes, and returns its length. Integers are encoded as big-endian strings
+// with no leading zeros, so zero is the empty string, and the integers
+// below 0x80 are their own encoding.
+func rlpUint(b []byte, x uint64) int {
+	if x != 0 && x < 0x80 {
+		b[0] = byte(x)
+		return 1
+	}
+	size := (bits.Len64(x) + 7) / 8
+	b[0] = 0x80 + byte(size)
+	for i := range size {
+		b[size-i] = byte(x >> (8 * i))
+	}
+	return 1 + size
+}
+
+// Create2Address returns the address of a contract created by deployer with
+// the CREATE2 opcode of EIP-1014: the last 20 bytes of the Keccak-256
+// digest of the byte 0xff, deployer, salt and the Keccak-256 digest of the
+// init code, in that order. Note that initCodeHash is the digest of the
+// init code, including the constructor arguments, not of the deployed
+// runtime code; Create2AddressFromCode hashes the init code itself.
+func Create2Address(deployer [AddressLength]byte, salt, initCodeHash [32]byte) (addr [AddressLength]byte) {
+	var buf [1 + AddressLength + 32 + 32]byte
+	buf[0] = 0xff
+	copy(buf[1:], deployer[:])
+	copy(buf[1+AddressLength:], salt[:])
+	copy(buf[1+AddressLength+32:], initCodeHash[:])
+	digest := Sum256(buf[:])
+	copy(addr[:], digest[12:])
+	return
+}
+
+// Create2AddressFromCode is Create2Address with the init code, which it
+// hashes, rather than its digest.
+func Create2AddressFromCode(deployer [AddressLength]byte, salt [32]byte, initCode []byte) [AddressLength]byte {
+	return Create2Address(deployer, salt, Sum256(initCode))
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func bigHex(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("bad hex " + s)
+	}
+	return x
+}
+
+func TestPubkeyToAddress(t *testing.T) {
+	// The public key of the private key 1 is the generator of secp256k1.
+	g := ecdsa.PublicKey{
+		X: bigHex("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		Y: bigHex("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+	}
+	addr := PubkeyToAddress(g)
+	if got, want := hex.EncodeToString(addr[:]), "7e5f4552091a69125d5dfcb7b8c2659029395bdf"; got != want {
+		t.Errorf("PubkeyToAddress(G) = %s, want %s", got, want)
+	}
+
+	// Short coordinates are padded to 32 bytes.
+	small := ecdsa.PublicKey{X: big.NewInt(1), Y: big.NewInt(0x0203)}
+	var point [64]byte
+	point[31], point[62], point[63] = 1, 2, 3
+	digest := Sum256(point[:])
+	if got := PubkeyToAddress(small); [20]byte(digest[12:]) != got {
+		t.Errorf("PubkeyToAddress with short coordinates = %x, want %x", got, digest[12:])
+	}
+
+	for _, pub := range []ecdsa.PublicKey{
+		{},
+		{X: big.NewInt(1)},
+		{X: big.NewInt(-1), Y: big.NewInt(1)},
+		{X: new(big.Int).Lsh(big.NewInt(1), 256), Y: big.NewInt(1)},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("PubkeyToAddress(%v, %v) did not panic", pub.X, pub.Y)
+				}
+			}()
+			PubkeyToAddress(pub)
+		}()
+	}
+}
+
+func TestChecksumAddress(t *testing.T) {
+	for _, tc := range []struct {
+		chainID uint64 // zero for EIP-55
+		addrs   []string
+	}{
+		// The test vectors of EIP-55 and EIP-1191.
+		{0, []string{
+			"0x52908400098527886E0F7030069857D2E4169EE7",
+			"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+			"0xde709f2102306220921060314715629080e2fb77",
+			"0x27b1fdb04752bbc536007a920d24acb045561c26",
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+			"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		}},
+		{30, []string{
+			"0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD",
+			"0xFb6916095cA1Df60bb79ce92cE3EA74c37c5d359",
+			"0xDBF03B407c01E7CD3cBea99509D93F8Dddc8C6FB",
+			"0xD1220A0Cf47c7B9BE7a2e6ba89F429762E7B9adB",
+		}},
+		{31, []string{
+			"0x5aAeb6053F3e94c9b9A09F33669435E7EF1BEaEd",
+			"0xFb6916095CA1dF60bb79CE92ce3Ea74C37c5D359",
+			"0xdbF03B407C01E7cd3cbEa99509D93f8dDDc8C6fB",
+		}},
+	} {
+		for _, want := range tc.addrs {
+			var addr [AddressLength]byte
+			hex.Decode(addr[:], []byte(strings.ToLower(want[2:])))
+			got, err := ChecksumAddress(addr), ValidateChecksum(want)
+			if tc.chainID != 0 {
+				got, err = ChecksumAddressForChain(addr, tc.chainID), ValidateChecksumForChain(want, tc.chainID)
+			}
+			if got != want {
+				t.Errorf("chain %d: checksum of %x = %s, want %s", tc.chainID, addr, got, want)
+			}
+			if err != nil {
+				t.Errorf("chain %d: validating %s: %v", tc.chainID, want, err)
+			}
+		}
+	}
+}
+
+func TestValidateChecksum(t *testing.T) {
+	for _, tc := range []struct {
+		s   string
+		err bool
+	}{
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", true},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+		{"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"0X5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe", true},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAedd", true},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", true},
+		{"", true},
+	} {
+		if err := ValidateChecksum(tc.s); (err != nil) != tc.err {
+			t.Errorf("ValidateChecksum(%q) = %v", tc.s, err)
+		}
+	}
+	if err := ValidateChecksum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"); err != ErrAddressChecksum {
+		t.Errorf("ValidateChecksum of a lowercase address = %v, want ErrAddressChecksum", err)
+	}
+	if err := ValidateChecksumForChain("0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD", 31); err != ErrAddressChecksum {
+		t.Errorf("ValidateChecksumForChain of an address of another chain = %v, want ErrAddressChecksum", err)
+	}
+}
+
+func TestCreateAddress(t *testing.T) {
+	var sender [AddressLength]byte
+	hex.Decode(sender[:], []byte("6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0"))
+	for nonce, want := range []string{
+		"cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+		"f778b86fa74e846c4f0a1fbd1335fe81c00a0c91",
+		"fffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c",
+	} {
+		addr := CreateAddress(sender, uint64(nonce))
+		if got := hex.EncodeToString(addr[:]); got != want {
+			t.Errorf("CreateAddress(%x, %d) = %s, want %s", sender, nonce, got, want)
+		}
+	}
+}
+
+func TestRLPUint(t *testing.T) {
+	for _, tc := range []struct {
+		x    uint64
+		want string
+	}{
+		{0, "80"},
+		{1, "01"},
+		{0x7f, "7f"},
+		{0x80, "8180"},
+		{0xff, "81ff"},
+		{0x100, "820100"},
+		{0x0102030405060708, "880102030405060708"},
+		{1<<64 - 1, "88ffffffffffffffff"},
+	} {
+		var b [9]byte
+		if got := hex.EncodeToString(b[:rlpUint(b[:], tc.x)]); got != tc.want {
+			t.Errorf("rlpUint(%#x) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestCreate2Address(t *testing.T) {
+	// The examples of EIP-1014.
+	for _, tc := range []struct {
+		deployer, salt, initCode, want string
+	}{
+		{"0000000000000000000000000000000000000000", "00", "00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"deadbeef00000000000000000000000000000000", "00", "00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"deadbeef00000000000000000000000000000000", "000000000000000000000000feed000000000000000000000000000000000000", "00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0000000000000000000000000000000000000000", "00", "deadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+		{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", "deadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"0000000000000000000000000000000000000000", "00", "", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	} {
+		var deployer [AddressLength]byte
+		var salt [32]byte
+		hex.Decode(deployer[:], []byte(tc.deployer))
+		s, _ := hex.DecodeString(tc.salt)
+		copy(salt[32-len(s):], s)
+		initCode, _ := hex.DecodeString(tc.initCode)
+
+		if got := ChecksumAddress(Create2AddressFromCode(deployer, salt, initCode)); got != tc.want {
+			t.Errorf("Create2AddressFromCode(%x, %x, %x) = %s, want %s", deployer, salt, initCode, got, tc.want)
+		}
+		if got := ChecksumAddress(Create2Address(deployer, salt, Sum256(initCode))); got != tc.want {
+			t.Errorf("Create2Address(%x, %x, hash of %x) = %s, want %s", deployer, salt, initCode, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "unsafe"
+
+// cacheLineSize is the size of a cache line on amd64 and most arm64 CPUs.
+// Data written by different goroutines is kept in different lines of this
+// size, so that cores do not invalidate each other's caches when they
+// update their own data, which is called false sharing.
+const cacheLineSize = 64
+
+// stateLines is the number of cache lines a state spans once aligned.
+const stateLines = (unsafe.Sizeof(state{}) + cacheLineSize - 1) / cacheLineSize
+
+// newState returns a copy of s on the heap, aligned to a cache line and in
+// lines of its own. Hashes used on many goroutines at once, such as those
+// of a sync.Pool, do not then slow each other down through false sharing,
+// whatever the size of state on the platform or with the keccak_counters
+// tag.
+//
+// The Go allocator does not align objects to more than their size class
+// allows, so newState allocates a line more and places the state at the
+// first line boundary. This is safe as state holds no pointers, which
+// TestStateHasNoPointers checks.
+func newState(s state) *state {
+	buf := new([(stateLines + 1) * cacheLineSize]byte)
+	off := -uintptr(unsafe.Pointer(buf)) & (cacheLineSize - 1)
+	d := (*state)(unsafe.Pointer(&buf[off]))
+	*d = s
+	return d
+}
+
+// lineRun rounds n up to a count of consecutive elements of size bytes
+// that fills whole cache lines. Goroutines writing runs of that many
+// elements of an aligned slice then write to distinct cache lines.
+func lineRun(n, size int) int {
+	unit := cacheLineSize / min(size&-size, cacheLineSize)
+	return (n + unit - 1) / unit * unit
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+)
+
+func TestNewStateAligned(t *testing.T) {
+	// Allocate a few states of each kind, so that they do not all come
+	// first in their span.
+	for range 10 {
+		for _, s := range []*state{
+			NewLegacyKeccak256().(*state),
+			New512().(*state),
+			NewShake128().(*state),
+			NewLegacyKeccak256().(*state).clone(),
+		} {
+			if p := uintptr(unsafe.Pointer(s)); p%cacheLineSize != 0 {
+				t.Errorf("state at %#x is not aligned to a cache line", p)
+			}
+		}
+	}
+}
+
+// TestStateHasNoPointers checks that newState may place a state in a byte
+// array, which the garbage collector does not scan. It must pass with and
+// without the keccak_counters tag, which changes the fields of state.
+func TestStateHasNoPointers(t *testing.T) {
+	var walk func(typ reflect.Type, path string)
+	walk = func(typ reflect.Type, path string) {
+		switch typ.Kind() {
+		case reflect.Array:
+			walk(typ.Elem(), path+"[]")
+		case reflect.Struct:
+			for i := range typ.NumField() {
+				f := typ.Field(i)
+				walk(f.Type, path+"."+f.Name)
+			}
+		case reflect.Pointer, reflect.UnsafePointer, reflect.Map, reflect.Chan,
+			reflect.Func, reflect.Interface, reflect.Slice, reflect.String:
+			t.Errorf("%s is a %v, which holds a pointer", path, typ)
+		}
+	}
+	walk(reflect.TypeFor[state](), "state")
+}
+
+func TestLineRun(t *testing.T) {
+	for _, tc := range []struct{ n, size, want int }{
+		{0, 32, 0},
+		{1, 32, 2},
+		{2, 32, 2},
+		{3, 32, 4},
+		{5, 64, 5},
+		{3, 200, 8},
+		{1, 28, 16},
+		{17, 28, 32},
+		{1, 128, 1},
+	} {
+		if got := lineRun(tc.n, tc.size); got != tc.want {
+			t.Errorf("lineRun(%d, %d) = %d, want %d", tc.n, tc.size, got, tc.want)
+		}
+	}
+}
+
+// packedState is a state that shares cache lines with its neighbours in a
+// slice, as states allocated next to each other may without newState.
+type packedState struct {
+	state
+	_ [cacheLineSize / 2]byte
+}
+
+// BenchmarkFalseSharing writes short messages to a state per goroutine,
+// with the states packed next to each other or allocated by newState. The
+// difference shows with GOMAXPROCS above one, and grows with the number of
+// cores.
+func BenchmarkFalseSharing(b *testing.B) {
+	msg := ptn(16)
+	run := func(b *testing.B, states []*state) {
+		var next atomic.Int32
+		b.SetBytes(int64(len(msg)))
+		b.RunParallel(func(pb *testing.PB) {
+			d := states[int(next.Add(1)-1)%len(states)]
+			for pb.Next() {
+				d.Write(msg)
+			}
+		})
+	}
+	procs := runtime.GOMAXPROCS(0)
+	b.Run("packed", func(b *testing.B) {
+		packed := make([]packedState, procs)
+		states := make([]*state, procs)
+		for i := range packed {
+			packed[i].state = state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+			states[i] = &packed[i].state
+		}
+		run(b, states)
+	})
+	b.Run("padded", func(b *testing.B) {
+		states := make([]*state, procs)
+		for i := range states {
+			states[i] = newState(state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak})
+		}
+		run(b, states)
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file selects among the implementations of the permutation. Each
+// architecture detects the CPU features its assembly needs in its cpu_*.go
+// file, and uses an implementation if the CPU supports it and the backend
+// it belongs to is allowed here.
+//
+// Setting keccakbackend=name in the GODEBUG environment variable caps the
+// backends used at startup to name and the slower ones, for debugging and
+// benchmarking: keccakbackend=generic forces the pure-Go code everywhere.
+
+import (
+	"os"
+	"strings"
+)
+
+// backendRanks ranks the backends from the slowest to the fastest. The
+// pure-Go code comes first, followed by the assembly of each architecture.
+// The backends of different architectures never meet, so they share ranks.
+var backendRanks = map[string]int{
+	"generic": 0,
+	"scalar":  1, // amd64, loong64 with the keccak_experimental build tag
+	"sse2":    1, // 386
+	"sha3":    1, // arm64
+	"neon":    1, // arm, with the keccak_experimental build tag
+	"vsx":     1, // ppc64le, with the keccak_experimental build tag, multi-buffer only
+	"lsx":     2, // loong64, with the keccak_experimental build tag, multi-buffer only
+	"simd128": 1, // wasm, with the keccak_simd128 build tag, multi-buffer only
+	"zbb":     1, // riscv64, with the keccak_experimental build tag
+	"rvv":     2, // riscv64, with the keccak_experimental build tag, multi-buffer only
+	"kimd":    1, // s390x
+	"xkcp":    1, // cgo, with the keccak_xkcp build tag
+	"bmi2":    2, // amd64
+	"avx2":    3, // amd64, multi-buffer only
+	"avx512":  4, // amd64
+}
+
+// backendCap is the backend set with GODEBUG=keccakbackend=name, or the
+// empty string if it is unset or unknown.
+var backendCap = parseBackendCap(os.Getenv("GODEBUG"))
+
+func parseBackendCap(godebug string) string {
+	var name string
+	// As for the settings of the Go runtime, the last one wins.
+	for _, kv := range strings.Split(godebug, ",") {
+		if v, ok := strings.CutPrefix(kv, "keccakbackend="); ok {
+			name = v
+		}
+	}
+	if _, ok := backendRanks[name]; !ok {
+		return ""
+	}
+	return name
+}
+
+// backendAllowed reports whether the backend called name may be used.
+func backendAllowed(name string) bool {
+	return backendCap == "" || backendRanks[name] <= backendRanks[backendCap]
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+)
+
+func TestParseBackendCap(t *testing.T) {
+	for _, tc := range []struct {
+		godebug, want string
+	}{
+		{"", ""},
+		{"keccakbackend=generic", "generic"},
+		{"gctrace=1,keccakbackend=bmi2,madvdontneed=1", "bmi2"},
+		{"keccakbackend=avx512,keccakbackend=scalar", "scalar"},
+		{"keccakbackend=mmx", ""},
+		{"xkeccakbackend=generic", ""},
+	} {
+		if got := parseBackendCap(tc.godebug); got != tc.want {
+			t.Errorf("parseBackendCap(%q) = %q, want %q", tc.godebug, got, tc.want)
+		}
+	}
+}
+
+func TestBackendAllowed(t *testing.T) {
+	defer func(old string) { backendCap = old }(backendCap)
+	backendCap = "bmi2"
+	for name, want := range map[string]bool{"generic": true, "scalar": true, "bmi2": true, "avx2": false, "avx512": false} {
+		if got := backendAllowed(name); got != want {
+			t.Errorf("with keccakbackend=bmi2, backendAllowed(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+// The permutations chosen under each cap pass the tests of the
+// permutations and of the functions dispatching to them.
+func TestBackendCaps(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the tests again in subprocesses")
+	}
+	if runtime.GOOS == "js" || runtime.GOOS == "wasip1" {
+		t.Skip("cannot run subprocesses on " + runtime.GOOS)
+	}
+	for name := range backendRanks {
+		cmd := exec.Command(os.Args[0], "-test.run=^(TestKeccakF1600|TestKeccakP1600|TestKeccakF1600x[248]|TestSumMulti|TestHashBatch256)$")
+		cmd.Env = append(os.Environ(), "GODEBUG=keccakbackend="+name)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("with keccakbackend=%s: %v\n%s", name, err, out)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file hashes batches of independent messages, such as the nodes of a
+// level of a Merkle tree, several at a time with the multi-buffer
+// permutations of multibuffer.go.
+
+import (
+	"runtime"
+	"sync"
+)
+
+// batchGroupSize is the number of messages a Batch256 accumulates before
+// hashing them.
+const batchGroupSize = 1024
+
+// HashBatch256 returns the legacy Keccak-256 digests of msgs, in order, as
+// Sum256 computes them one at a time.
+//
+// Where the CPU supports it, the messages are hashed several at a time with
+// SIMD instructions, whatever their lengths. Large batches are also spread
+// over up to GOMAXPROCS goroutines.
+func HashBatch256(msgs [][]byte) [][32]byte {
+	out := make([]byte, 32*len(msgs))
+	sumBatch(msgs, rateK512, dsbyteKeccak, 32, out)
+	digests := make([][32]byte, len(msgs))
+	for i := range digests {
+		digests[i] = [32]byte(out[32*i:])
+	}
+	return digests
+}
+
+// Batch256 collects messages to hash with HashBatch256 one at a time, for
+// callers producing them as a stream. The messages are hashed in groups as
+// they are added. The zero value is ready to use.
+type Batch256 struct {
+	pending [][]byte
+	digests [][32]byte
+}
+
+// Add adds msg to the batch. The batch keeps a reference to msg, which must
+// not be modified until Digests returns.
+func (b *Batch256) Add(msg []byte) {
+	b.pending = append(b.pending, msg)
+	if len(b.pending) == batchGroupSize {
+		b.flush()
+	}
+}
+
+// Len returns the number of messages added since the last call to Digests.
+func (b *Batch256) Len() int {
+	return len(b.digests) + len(b.pending)
+}
+
+// Digests returns the legacy Keccak-256 digests of the messages added since
+// the last call to Digests, in the order they were added, and empties the
+// batch.
+func (b *Batch256) Digests() [][32]byte {
+	b.flush()
+	digests := b.digests
+	b.digests = nil
+	return digests
+}
+
+func (b *Batch256) flush() {
+	b.digests = append(b.digests, HashBatch256(b.pending)...)
+	clear(b.pending)
+	b.pending = b.pending[:0]
+}
+
+// sumBatch hashes each of msgs with the sponge of the given rate and domain
+// separation byte, and writes the digests of outputLen bytes, which must be
+// at most the rate, one after the other to out. Large batches are split in
+// runs of about the same amount of input, hashed concurrently.
+func sumBatch(msgs [][]byte, rate int, dsbyte byte, outputLen int, out []byte) {
+	// Count the blocks rather than the bytes, as short messages still
+	// take a permutation each.
+	size := 0
+	for _, m := range msgs {
+		size += (len(m)/rate + 1) * rate
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), len(msgs))
+	if size < parallelThreshold || workers < 2 {
+		sumRun(msgs, rate, dsbyte, outputLen, out)
+		return
+	}
+
+	var wg sync.WaitGroup
+	per := (size + workers - 1) / workers
+	for lo := 0; lo < len(msgs); {
+		hi, run := lo, 0
+		// Runs end on a cache line of out, which the goroutines write.
+		for hi < len(msgs) && (run < per || lineRun(hi, outputLen) != hi) {
+			run += (len(msgs[hi])/rate + 1) * rate
+			hi++
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			sumRun(msgs[lo:hi], rate, dsbyte, outputLen, out[lo*outputLen:hi*outputLen])
+		}(lo, hi)
+		lo = hi
+	}
+	wg.Wait()
+}
+
+// sumRun is sumBatch on the calling goroutine, with the widest multi-buffer
+// permutation that is faster than the single-state one.
+func sumRun(msgs [][]byte, rate int, dsbyte byte, outputLen int, out []byte) {
+	switch {
+	case fastX8:
+		sumMulti(keccakF1600x8, 1, msgs, rate, dsbyte, outputLen, out)
+	case fastX4:
+		sumMulti(keccakF1600x4, 2, msgs, rate, dsbyte, outputLen, out)
+	case fastX2:
+		sumMulti(keccakF1600x2, 1, msgs, rate, dsbyte, outputLen, out)
+	default:
+		for i, m := range msgs {
+			d := state{rate: rate, outputLen: outputLen, dsbyte: dsbyte}
+			d.Write(m)
+			d.Read(out[i*outputLen : (i+1)*outputLen])
+		}
+	}
+}
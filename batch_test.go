@@ -0,0 +1,77 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// batchMessages returns count messages of pseudorandom lengths below max.
+func batchMessages(count, max int) [][]byte {
+	r := rand.New(rand.NewPCG(1, 2))
+	msgs := make([][]byte, count)
+	for i := range msgs {
+		msgs[i] = ptn(r.IntN(max))
+	}
+	return msgs
+}
+
+func TestHashBatch256(t *testing.T) {
+	// The larger batches are hashed concurrently.
+	for _, count := range []int{0, 1, 7, 100, 2000} {
+		msgs := batchMessages(count, 600)
+		got := HashBatch256(msgs)
+		if len(got) != count {
+			t.Fatalf("HashBatch256 of %d messages returned %d digests", count, len(got))
+		}
+		for i, m := range msgs {
+			if want := Sum256(m); got[i] != want {
+				t.Errorf("%d messages: digest %d = %x, want %x", count, i, got[i], want)
+			}
+		}
+	}
+}
+
+func TestBatch256(t *testing.T) {
+	msgs := batchMessages(3*batchGroupSize+5, 300)
+	var b Batch256
+	for i := range 2 {
+		for _, m := range msgs {
+			b.Add(m)
+		}
+		if b.Len() != len(msgs) {
+			t.Errorf("round %d: Len = %d, want %d", i, b.Len(), len(msgs))
+		}
+		got := b.Digests()
+		if len(got) != len(msgs) {
+			t.Fatalf("round %d: %d digests, want %d", i, len(got), len(msgs))
+		}
+		for j, m := range msgs {
+			if want := Sum256(m); got[j] != want {
+				t.Errorf("round %d: digest %d = %x, want %x", i, j, got[j], want)
+			}
+		}
+		if b.Len() != 0 {
+			t.Errorf("round %d: Len after Digests = %d", i, b.Len())
+		}
+	}
+}
+
+func BenchmarkHashBatch256(b *testing.B) {
+	for _, size := range []int{32, 64, 256} {
+		msgs := make([][]byte, 1024)
+		for i := range msgs {
+			msgs[i] = ptn(size)
+		}
+		b.Run(fmt.Sprintf("%dx%d", len(msgs), size), func(b *testing.B) {
+			b.SetBytes(int64(len(msgs) * size))
+			for i := 0; i < b.N; i++ {
+				HashBatch256(msgs)
+			}
+		})
+	}
+}
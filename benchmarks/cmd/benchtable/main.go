@@ -0,0 +1,115 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Benchtable runs the comparison benchmarks once for each backend of
+// go-keccak available on this architecture, capping the backends with
+// GODEBUG=keccakbackend, and prints the throughput of every benchmark under
+// every backend as a table. Run it from the benchmarks directory:
+//
+//	go run ./cmd/benchtable [-bench regexp] [-count n] [-backends list]
+//
+// The implementations other than go-keccak do not depend on the backend,
+// so their rows vary only with noise, which gives an idea of how far the
+// numbers can be trusted.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// backends lists the backends of each architecture, from the slowest to
+// the fastest, as named by GODEBUG=keccakbackend.
+var backends = map[string][]string{
+	"amd64": {"generic", "scalar", "bmi2", "avx2", "avx512"},
+	"386":   {"generic", "sse2"},
+	"arm64": {"generic", "sha3"},
+	"s390x": {"generic", "kimd"},
+}
+
+func main() {
+	bench := flag.String("bench", ".", "run only the benchmarks matching `regexp`")
+	count := flag.Int("count", 5, "run each benchmark `n` times and report the median")
+	list := flag.String("backends", "", "comma-separated `list` of backends, instead of all those of GOARCH")
+	flag.Parse()
+
+	names := backends[runtime.GOARCH]
+	if names == nil {
+		names = []string{"generic"}
+	}
+	if *list != "" {
+		names = strings.Split(*list, ",")
+	}
+
+	// results maps each benchmark to its median throughput in MB/s under
+	// each backend.
+	results := map[string]map[string]float64{}
+	var order []string
+	for _, backend := range names {
+		cmd := exec.Command("go", "test", "-run", "^$", "-bench", *bench, "-count", strconv.Itoa(*count), ".")
+		cmd.Env = append(os.Environ(), "GODEBUG=keccakbackend="+backend)
+		cmd.Stderr = os.Stderr
+		out, err := cmd.Output()
+		if err != nil {
+			log.Fatalf("with keccakbackend=%s: %v\n%s", backend, err, out)
+		}
+		for name, runs := range parse(out) {
+			if results[name] == nil {
+				results[name] = map[string]float64{}
+				order = append(order, name)
+			}
+			slices.Sort(runs)
+			results[name][backend] = runs[len(runs)/2]
+		}
+	}
+	slices.Sort(order)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "MB/s\t%s\n", strings.Join(names, "\t"))
+	for _, name := range order {
+		fmt.Fprint(w, name)
+		for _, backend := range names {
+			fmt.Fprintf(w, "\t%.1f", results[name][backend])
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+}
+
+// parse returns the throughputs reported by each benchmark in the output
+// of go test, without the GOMAXPROCS suffix of their names.
+func parse(out []byte) map[string][]float64 {
+	runs := map[string][]float64{}
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) < 2 || !strings.HasPrefix(f[0], "Benchmark") {
+			continue
+		}
+		name := strings.TrimPrefix(f[0], "Benchmark")
+		if i := strings.LastIndexByte(name, '-'); i >= 0 {
+			if _, err := strconv.Atoi(name[i+1:]); err == nil {
+				name = name[:i]
+			}
+		}
+		for i := 1; i < len(f); i++ {
+			if f[i] == "MB/s" {
+				if v, err := strconv.ParseFloat(f[i-1], 64); err == nil {
+					runs[name] = append(runs[name], v)
+				}
+			}
+		}
+	}
+	return runs
+}
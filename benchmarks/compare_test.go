@@ -0,0 +1,92 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package benchmarks
+
+import (
+	"crypto/sha3"
+	"fmt"
+	"hash"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/filecoin-project/go-keccak"
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+// sizes are the message sizes of the workloads: an Ethereum word, a whole
+// Keccak-256 block, and streaming sizes.
+var sizes = []int{32, 136, 1 << 10, 64 << 10}
+
+// implementations are the hashes compared, by the name of their package.
+var implementations = []struct {
+	name string
+	fn   string
+	new  func() hash.Hash
+}{
+	{"go-keccak", "Keccak256", keccak.NewLegacyKeccak256},
+	{"x-crypto", "Keccak256", xsha3.NewLegacyKeccak256},
+	{"go-ethereum", "Keccak256", func() hash.Hash { return crypto.NewKeccakState() }},
+	{"go-keccak", "SHA3-256", keccak.New256},
+	{"x-crypto", "SHA3-256", xsha3.New256},
+	{"stdlib", "SHA3-256", func() hash.Hash { return sha3.New256() }},
+}
+
+func BenchmarkHash(b *testing.B) {
+	for _, impl := range implementations {
+		for _, size := range sizes {
+			b.Run(fmt.Sprintf("%s/%s/%d", impl.fn, impl.name, size), func(b *testing.B) {
+				data := make([]byte, size)
+				out := make([]byte, 0, 32)
+				h := impl.new()
+				b.SetBytes(int64(size))
+				for i := 0; i < b.N; i++ {
+					h.Reset()
+					h.Write(data)
+					out = h.Sum(out[:0])
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkSum256 compares the one-shot Keccak-256 functions, which need
+// no hash.Hash.
+func BenchmarkSum256(b *testing.B) {
+	for _, size := range sizes {
+		data := make([]byte, size)
+		b.Run(fmt.Sprintf("go-keccak/%d", size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				keccak.Sum256(data)
+			}
+		})
+		b.Run(fmt.Sprintf("go-ethereum/%d", size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				crypto.Keccak256Hash(data)
+			}
+		})
+	}
+}
+
+func BenchmarkShake128Read(b *testing.B) {
+	for _, impl := range []struct {
+		name string
+		new  func() interface{ Read([]byte) (int, error) }
+	}{
+		{"go-keccak", func() interface{ Read([]byte) (int, error) } { return keccak.NewShake128() }},
+		{"x-crypto", func() interface{ Read([]byte) (int, error) } { return xsha3.NewShake128() }},
+		{"stdlib", func() interface{ Read([]byte) (int, error) } { return sha3.NewSHAKE128() }},
+	} {
+		b.Run(impl.name, func(b *testing.B) {
+			out := make([]byte, 64<<10)
+			h := impl.new()
+			b.SetBytes(int64(len(out)))
+			for i := 0; i < b.N; i++ {
+				h.Read(out)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/filecoin-project/go-keccak/benchmarks
+
+go 1.25
+
+require (
+	github.com/ethereum/go-ethereum v1.16.5
+	github.com/filecoin-project/go-keccak v0.0.0
+)
+
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1 // indirect
+	github.com/holiman/uint256 v1.3.2 // indirect
+)
+
+require (
+	golang.org/x/crypto v0.43.0
+	golang.org/x/sys v0.37.0 // indirect
+)
+
+replace github.com/filecoin-project/go-keccak => ../
@@ -0,0 +1,46 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// Counters holds counts of the work done by hashes, for attributing CPU
+// time to hashing. They are only kept in builds with the keccak_counters
+// tag, which costs a few instructions per permutation and per call, and
+// are always zero otherwise.
+type Counters struct {
+	// Permutations is the number of Keccak permutations applied, whatever
+	// their width and number of rounds.
+	Permutations uint64
+
+	// Absorbed and Squeezed are the numbers of bytes of input absorbed
+	// and of output squeezed.
+	Absorbed, Squeezed uint64
+}
+
+// CountersEnabled reports whether the package was built with the
+// keccak_counters tag, and so keeps counters.
+const CountersEnabled = countersEnabled
+
+// GlobalCounters returns the work done by all the sponges and batch hashes
+// of the package since the program started. Direct calls to KeccakF1600
+// and KeccakP1600, and the Kravatte functions, are not counted.
+func GlobalCounters() Counters {
+	return globalCounters()
+}
+
+// HashCounters returns the work done by h since it was created, including
+// the work of its Sum method; Reset does not clear the counters, and Clone
+// copies them. It returns zero counters if h is not a sponge-based hash of
+// this package, such as a Keccak, SHA-3, SHAKE, cSHAKE or KMAC hash, or a
+// *Digest.
+func HashCounters(h any) Counters {
+	if c, ok := h.(interface{ counters() Counters }); ok {
+		return c.counters()
+	}
+	return Counters{}
+}
+
+func (d *state) counters() Counters { return d.counts.get() }
+
+func (d *Digest) counters() Counters { return d.s.counts.get() }
@@ -0,0 +1,30 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !keccak_counters
+
+package keccak
+
+// Without the keccak_counters tag, the counters take no space and their
+// methods compile to nothing.
+
+const countersEnabled = false
+
+func globalCounters() Counters { return Counters{} }
+
+func countPermutations(n int) {}
+
+func countAbsorbed(n int) {}
+
+func countSqueezed(n int) {}
+
+type stateCounters struct{}
+
+func (c *stateCounters) permuted(n int) {}
+
+func (c *stateCounters) absorbedBytes(n int) {}
+
+func (c *stateCounters) squeezedBytes(n int) {}
+
+func (c *stateCounters) get() Counters { return Counters{} }
@@ -0,0 +1,57 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_counters
+
+package keccak
+
+import "sync/atomic"
+
+const countersEnabled = true
+
+var global struct {
+	permutations, absorbed, squeezed atomic.Uint64
+}
+
+func globalCounters() Counters {
+	return Counters{
+		Permutations: global.permutations.Load(),
+		Absorbed:     global.absorbed.Load(),
+		Squeezed:     global.squeezed.Load(),
+	}
+}
+
+// countPermutations, countAbsorbed and countSqueezed add to the global
+// counters only, for the batch hashes, which have no state of their own.
+
+func countPermutations(n int) { global.permutations.Add(uint64(n)) }
+
+func countAbsorbed(n int) { global.absorbed.Add(uint64(n)) }
+
+func countSqueezed(n int) { global.squeezed.Add(uint64(n)) }
+
+// stateCounters are the counters of a sponge, which also add to the
+// global ones.
+type stateCounters struct {
+	permutations, absorbed, squeezed uint64
+}
+
+func (c *stateCounters) permuted(n int) {
+	c.permutations += uint64(n)
+	countPermutations(n)
+}
+
+func (c *stateCounters) absorbedBytes(n int) {
+	c.absorbed += uint64(n)
+	countAbsorbed(n)
+}
+
+func (c *stateCounters) squeezedBytes(n int) {
+	c.squeezed += uint64(n)
+	countSqueezed(n)
+}
+
+func (c *stateCounters) get() Counters {
+	return Counters{Permutations: c.permutations, Absorbed: c.absorbed, Squeezed: c.squeezed}
+}
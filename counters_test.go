@@ -0,0 +1,52 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "testing"
+
+// Run with -tags keccak_counters to test the counters themselves.
+func TestCounters(t *testing.T) {
+	h := NewLegacyKeccak256()
+	h.Write(ptn(300))
+	h.Sum(nil)
+	h.Reset()
+	h.Write(ptn(10))
+	var d Digest
+	d.Write(ptn(10))
+	d.Sum256()
+	if !CountersEnabled {
+		for _, c := range []Counters{HashCounters(h), HashCounters(&d), GlobalCounters()} {
+			if c != (Counters{}) {
+				t.Errorf("counters = %+v without the keccak_counters tag, want zero", c)
+			}
+		}
+		return
+	}
+
+	// Two blocks are permuted as they are filled, and the padded last block
+	// by Sum, which squeezes 32 bytes.
+	if got, want := HashCounters(h), (Counters{Permutations: 3, Absorbed: 310, Squeezed: 32}); got != want {
+		t.Errorf("HashCounters = %+v, want %+v", got, want)
+	}
+	if got, want := HashCounters(&d), (Counters{Permutations: 1, Absorbed: 10, Squeezed: 32}); got != want {
+		t.Errorf("HashCounters(*Digest) = %+v, want %+v", got, want)
+	}
+	if got := HashCounters(NewTupleHash128(nil, 32)); got != (Counters{}) {
+		t.Errorf("HashCounters of a TupleHash = %+v, want zero", got)
+	}
+
+	before := GlobalCounters()
+	Sum256(ptn(100))
+	HashBatch256([][]byte{ptn(32), ptn(32), ptn(200)})
+	after := GlobalCounters()
+	want := Counters{Permutations: 1 + 4, Absorbed: 100 + 264, Squeezed: 32 + 96}
+	if got := (Counters{
+		Permutations: after.Permutations - before.Permutations,
+		Absorbed:     after.Absorbed - before.Absorbed,
+		Squeezed:     after.Squeezed - before.Squeezed,
+	}); got != want {
+		t.Errorf("GlobalCounters grew by %+v, want %+v", got, want)
+	}
+}
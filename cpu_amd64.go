@@ -0,0 +1,91 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego && !noasm && gc
+
+package keccak
+
+// This file detects the CPU features used by the assembly implementations,
+// as golang.org/x/sys/cpu would, without depending on it.
+
+// cpuid and xgetbv are implemented in cpu_amd64.s.
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+// hasBMI reports whether the CPU supports the BMI1 and BMI2 extensions,
+// which provide ANDN and RORX.
+var hasBMI = detectBMI()
+
+func detectBMI() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	const (
+		bmi1 = 1 << 3
+		bmi2 = 1 << 8
+	)
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&bmi1 != 0 && ebx7&bmi2 != 0
+}
+
+// hasAVX2 reports whether the CPU and the operating system support AVX2.
+var hasAVX2 = detectAVX2()
+
+func detectAVX2() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+
+	// The operating system must have enabled XSAVE and the SSE and AVX
+	// state.
+	const osxsave = 1 << 27
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&osxsave == 0 {
+		return false
+	}
+	const xcr0AVX = 1<<1 | 1<<2
+	if xcr0, _ := xgetbv(); xcr0&xcr0AVX != xcr0AVX {
+		return false
+	}
+
+	const avx2 = 1 << 5
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&avx2 != 0
+}
+
+// hasAVX512 reports whether the CPU and the operating system support the
+// AVX-512 Foundation and Vector Length extensions, including the upper 16
+// vector registers.
+var hasAVX512 = detectAVX512()
+
+func detectAVX512() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+
+	// The operating system must have enabled XSAVE and the state of the
+	// opmask registers, of the upper halves of ZMM0-15 and of ZMM16-31,
+	// besides the SSE and AVX state.
+	const osxsave = 1 << 27
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&osxsave == 0 {
+		return false
+	}
+	const xcr0AVX512 = 1<<1 | 1<<2 | 1<<5 | 1<<6 | 1<<7
+	if xcr0, _ := xgetbv(); xcr0&xcr0AVX512 != xcr0AVX512 {
+		return false
+	}
+
+	const (
+		avx512f  = 1 << 16
+		avx512vl = 1 << 31
+	)
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&avx512f != 0 && ebx7&avx512vl != 0
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && darwin && !ios && !purego && !noasm && gc
+
+package keccak
+
+import "syscall"
+
+// useSHA3 reports whether to use the Armv8.2 SHA-3 instructions. All Apple
+// silicon has them, but macOS is asked anyway, as the Go runtime does.
+var useSHA3 = sysctlEnabled("hw.optional.armv8_2_sha3") && backendAllowed("sha3")
+
+func sysctlEnabled(name string) bool {
+	v, err := syscall.Sysctl(name)
+	// The value is a little-endian int32, with trailing zeros trimmed.
+	return err == nil && len(v) > 0 && v[0] != 0
+}
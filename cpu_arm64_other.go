@@ -0,0 +1,13 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && (!darwin || ios) && !purego && !noasm && gc
+
+package keccak
+
+// useSHA3 reports whether to use the Armv8.2 SHA-3 instructions. Outside of
+// Apple silicon, notably on Graviton3 and Neoverse cores, they are slower
+// than the generic code, whose rotations the compiler folds into the
+// operands of EOR and BIC, so they are never used.
+const useSHA3 = false
@@ -0,0 +1,35 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm && linux && !purego && !noasm && gc
+
+package keccak
+
+import (
+	"encoding/binary"
+	"os"
+)
+
+// hasNEON reports whether the CPU supports NEON, from the hardware
+// capabilities the kernel passes in the auxiliary vector, as
+// golang.org/x/sys/cpu would, without depending on it.
+var hasNEON = detectNEON()
+
+func detectNEON() bool {
+	const (
+		atHWCAP   = 16
+		hwcapNEON = 1 << 12
+	)
+	auxv, err := os.ReadFile("/proc/self/auxv")
+	if err != nil {
+		return false
+	}
+	// The vector is a list of pairs of 32-bit words, a tag and a value.
+	for ; len(auxv) >= 8; auxv = auxv[8:] {
+		if binary.LittleEndian.Uint32(auxv) == atHWCAP {
+			return binary.LittleEndian.Uint32(auxv[4:])&hwcapNEON != 0
+		}
+	}
+	return false
+}
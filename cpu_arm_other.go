@@ -0,0 +1,10 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm && !linux && !purego && !noasm && gc
+
+package keccak
+
+// hasNEON reports whether the CPU supports NEON. Only Linux is asked.
+const hasNEON = false
@@ -0,0 +1,36 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build loong64 && !purego && !noasm && gc
+
+package keccak
+
+import (
+	"encoding/binary"
+	"os"
+)
+
+// hasLSX reports whether the CPU supports LSX and the kernel saves its
+// registers, from the hardware capabilities the kernel passes in the
+// auxiliary vector, as golang.org/x/sys/cpu would, without depending on it.
+// Go only supports loong64 on Linux.
+var hasLSX = detectLSX()
+
+func detectLSX() bool {
+	const (
+		atHWCAP  = 16
+		hwcapLSX = 1 << 4
+	)
+	auxv, err := os.ReadFile("/proc/self/auxv")
+	if err != nil {
+		return false
+	}
+	// The vector is a list of pairs of 64-bit words, a tag and a value.
+	for ; len(auxv) >= 16; auxv = auxv[16:] {
+		if binary.LittleEndian.Uint64(auxv) == atHWCAP {
+			return binary.LittleEndian.Uint64(auxv[8:])&hwcapLSX != 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build riscv64 && linux && !purego && !noasm && gc
+
+package keccak
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// This file detects the CPU features used by the assembly implementations,
+// as golang.org/x/sys/cpu would, without depending on it: the riscv_hwprobe
+// system call reports the extensions all the cores support.
+
+// hasRVV and hasZbb report whether the CPU supports the vector and the basic
+// bit-manipulation extensions.
+var hasRVV, hasZbb = detectRISCV()
+
+func detectRISCV() (v, zbb bool) {
+	const (
+		sysRISCVHWProbe = 258
+		keyIMAExt0      = 4
+		imaV            = 1 << 2
+		extZbb          = 1 << 4
+	)
+	pairs := [1]struct {
+		key   int64
+		value uint64
+	}{{key: keyIMAExt0}}
+	_, _, errno := syscall.RawSyscall6(sysRISCVHWProbe, uintptr(unsafe.Pointer(&pairs[0])), uintptr(len(pairs)), 0, 0, 0, 0)
+	// Kernels older than 6.4 fail the call, and those that do not know the
+	// key set it to -1.
+	if errno != 0 || pairs[0].key != keyIMAExt0 {
+		return false, false
+	}
+	return pairs[0].value&imaV != 0, pairs[0].value&extZbb != 0
+}
@@ -0,0 +1,11 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build riscv64 && !linux && !purego && !noasm && gc
+
+package keccak
+
+// hasRVV and hasZbb report whether the CPU supports the vector and the
+// basic bit-manipulation extensions. Only Linux is asked.
+const hasRVV, hasZbb = false, false
@@ -0,0 +1,43 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s390x && !purego && !noasm && gc
+
+package keccak
+
+// This file detects the CPU features used by the KIMD implementation, as
+// golang.org/x/sys/cpu would, without depending on it.
+
+// stfle, kimdQuery and klmdQuery are implemented in cpu_s390x.s. Each
+// returns a bit string where bit i, counting from the most significant bit
+// of the first word, is set if facility or function code i is available.
+
+func stfle() [4]uint64
+
+func kimdQuery() [2]uint64
+
+func klmdQuery() [2]uint64
+
+func bitIsSet(bits []uint64, i uint) bool {
+	return bits[i/64]&(1<<(63-i%64)) != 0
+}
+
+// hasKIMD reports whether the CPU implements the SHA-3 and SHAKE function
+// codes of the KIMD and KLMD instructions of the message-security assist.
+var hasKIMD = detectKIMD()
+
+func detectKIMD() bool {
+	const msa = 17
+	facilities := stfle()
+	if !bitIsSet(facilities[:], msa) {
+		return false
+	}
+	kimd, klmd := kimdQuery(), klmdQuery()
+	for fc := kimdSHA3224; fc <= kimdShake256; fc++ {
+		if !bitIsSet(kimd[:], uint(fc)) || !bitIsSet(klmd[:], uint(fc)) {
+			return false
+		}
+	}
+	return true
+}
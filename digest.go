@@ -0,0 +1,59 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// Digest is a legacy Keccak-256 hash as a value type. Unlike the hash.Hash
+// returned by NewLegacyKeccak256, which is always allocated on the heap, a
+// Digest can be declared as a local variable that stays on the stack, or
+// embedded in another struct. The zero value is ready to use, and *Digest
+// implements hash.Hash.
+//
+// A Digest holds no pointers, so assigning it to another variable forks the
+// hash: both copies continue independently from the data written so far.
+type Digest struct {
+	s state
+}
+
+// sponge returns the state of d, initializing it if d is the zero value.
+func (d *Digest) sponge() *state {
+	if d.s.rate == 0 {
+		d.s = state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	}
+	return &d.s
+}
+
+// BlockSize returns the rate of Keccak-256, 136 bytes.
+func (d *Digest) BlockSize() int { return rateK512 }
+
+// Size returns the size of a Keccak-256 digest, 32 bytes.
+func (d *Digest) Size() int { return 32 }
+
+// Reset returns d to the initial state.
+func (d *Digest) Reset() {
+	d.sponge().Reset()
+}
+
+// Write absorbs more data into the hash's state. It never returns an error.
+func (d *Digest) Write(p []byte) (int, error) {
+	return d.sponge().Write(p)
+}
+
+// WriteString absorbs the bytes of s, like Write, without converting s to a
+// byte slice.
+func (d *Digest) WriteString(s string) (int, error) {
+	return d.sponge().WriteString(s)
+}
+
+// Sum appends the digest of the data written so far to b and returns the
+// resulting slice. It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	return d.sponge().AppendSum(b)
+}
+
+// Sum256 returns the digest of the data written so far. It does not change
+// the underlying hash state.
+func (d *Digest) Sum256() [32]byte {
+	return d.sponge().Sum256()
+}
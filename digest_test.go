@@ -0,0 +1,55 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"hash"
+	"testing"
+)
+
+var _ hash.Hash = (*Digest)(nil)
+
+func TestDigest(t *testing.T) {
+	for _, n := range []int{0, 32, 135, 136, 1000} {
+		msg := ptn(n)
+		want := Sum256(msg)
+
+		var d Digest
+		d.Write(msg)
+		if got := d.Sum256(); got != want {
+			t.Errorf("Digest.Sum256(%d bytes) = %x, want %x", n, got, want)
+		}
+		if got := d.Sum([]byte("prefix")); !bytes.Equal(got, append([]byte("prefix"), want[:]...)) {
+			t.Errorf("Digest.Sum(%d bytes) = %x, want prefix and %x", n, got, want)
+		}
+
+		// A copy continues independently.
+		c := d
+		c.WriteString("more")
+		if got := d.Sum256(); got != want {
+			t.Errorf("Digest.Sum256(%d bytes) after writing to a copy = %x, want %x", n, got, want)
+		}
+		if got, want := c.Sum256(), Sum256(append(msg, "more"...)); got != want {
+			t.Errorf("copied Digest.Sum256 = %x, want %x", got, want)
+		}
+
+		d.Reset()
+		if got, want := d.Sum256(), Sum256(nil); got != want {
+			t.Errorf("Digest.Sum256 after Reset = %x, want %x", got, want)
+		}
+	}
+}
+
+func TestDigestAllocs(t *testing.T) {
+	msg := ptn(64)
+	if n := testing.AllocsPerRun(10, func() {
+		var d Digest
+		d.Write(msg)
+		d.Sum256()
+	}); n > 0 {
+		t.Errorf("Digest allocated %v times, want 0", n)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	// drbgSeedSize is the size of the working state V. It is twice the
+	// security strength of 256 bits.
+	drbgSeedSize = 64
+
+	// drbgMinEntropy is the minimum size of the entropy input, the
+	// security strength in bytes.
+	drbgMinEntropy = 32
+
+	// drbgReseedInterval is the number of Generate calls allowed between
+	// reseeds, the maximum of NIST SP 800-90A for Hash_DRBG.
+	drbgReseedInterval = 1 << 48
+
+	// drbgMaxRequest is the maximum output of a single Generate call,
+	// 2^19 bits as for Hash_DRBG.
+	drbgMaxRequest = 1 << 16
+)
+
+var (
+	// ErrReseedRequired is returned by Generate when the reseed interval
+	// has elapsed and no entropy source is set.
+	ErrReseedRequired = errors.New("keccak: DRBG reseed required")
+
+	errNoEntropySource = errors.New("keccak: prediction resistance requires an entropy source")
+	errShortEntropy    = errors.New("keccak: DRBG entropy input must be at least 32 bytes")
+)
+
+// ShakeDRBG is a deterministic random bit generator built on SHAKE256,
+// following the interface of Hash_DRBG from NIST SP 800-90A: it is
+// instantiated, reseeded and asked to generate output with optional
+// additional input, and reseeds from its entropy source when prediction
+// resistance is requested.
+//
+// Each operation is a TupleHashXOF256 of the working state V and the
+// inputs, with a customization string naming the operation. Generate
+// returns the start of the output and replaces V with the next 64 bytes,
+// so that a compromise of the state does not reveal earlier outputs.
+//
+// ShakeDRBG is not a NIST-approved DRBG mechanism. It is not safe for
+// concurrent use.
+type ShakeDRBG struct {
+	v             [drbgSeedSize]byte
+	reseedCounter uint64
+	source        io.Reader
+}
+
+// NewShakeDRBG instantiates a DRBG from entropy, which must be at least 32
+// bytes long, a nonce and an optional personalization string.
+func NewShakeDRBG(entropy, nonce, personalization []byte) (*ShakeDRBG, error) {
+	if len(entropy) < drbgMinEntropy {
+		return nil, errShortEntropy
+	}
+	d := &ShakeDRBG{reseedCounter: 1}
+	d.derive([]byte("SHAKE256-DRBG instantiate"), nil, entropy, nonce, personalization)
+	return d, nil
+}
+
+// SetEntropySource sets the source of entropy input used to reseed when
+// prediction resistance is requested or the reseed interval has elapsed.
+// It is typically crypto/rand.Reader.
+func (d *ShakeDRBG) SetEntropySource(source io.Reader) {
+	d.source = source
+}
+
+// derive computes the TupleHashXOF256 of the elements customized by S,
+// writes its output to out and then replaces the working state with the
+// following bytes.
+func (d *ShakeDRBG) derive(S, out []byte, elements ...[]byte) {
+	h := NewTupleHashXOF256(S)
+	for _, e := range elements {
+		h.WriteElement(e)
+	}
+	h.Read(out)
+	h.Read(d.v[:])
+}
+
+// Reseed mixes fresh entropy, which must be at least 32 bytes long, and
+// optional additional input into the state, and resets the reseed
+// counter.
+func (d *ShakeDRBG) Reseed(entropy, additionalInput []byte) error {
+	if len(entropy) < drbgMinEntropy {
+		return errShortEntropy
+	}
+	d.derive([]byte("SHAKE256-DRBG reseed"), nil, d.v[:], entropy, additionalInput)
+	d.reseedCounter = 1
+	return nil
+}
+
+// reseedFromSource reseeds with entropy read from the entropy source.
+func (d *ShakeDRBG) reseedFromSource(additionalInput []byte) error {
+	entropy := make([]byte, drbgMinEntropy)
+	if _, err := io.ReadFull(d.source, entropy); err != nil {
+		return err
+	}
+	return d.Reseed(entropy, additionalInput)
+}
+
+// Generate fills out, which must be at most 65536 bytes long, with
+// pseudorandom bytes, mixing in the optional additional input. If
+// predictionResistance is set, the DRBG first reseeds from its entropy
+// source. When the reseed interval has elapsed, Generate reseeds from the
+// entropy source, or returns ErrReseedRequired if there is none.
+func (d *ShakeDRBG) Generate(out, additionalInput []byte, predictionResistance bool) error {
+	if len(out) > drbgMaxRequest {
+		return errors.New("keccak: DRBG request too large")
+	}
+	if predictionResistance && d.source == nil {
+		return errNoEntropySource
+	}
+	if predictionResistance || d.reseedCounter > drbgReseedInterval {
+		if d.source == nil {
+			return ErrReseedRequired
+		}
+		// The additional input is used by the reseed and not again.
+		if err := d.reseedFromSource(additionalInput); err != nil {
+			return err
+		}
+		additionalInput = nil
+	}
+
+	d.derive([]byte("SHAKE256-DRBG generate"), out, d.v[:], additionalInput)
+	d.reseedCounter++
+	return nil
+}
+
+// Read fills p with pseudorandom bytes, calling Generate without
+// additional input for each block of at most 65536 bytes.
+func (d *ShakeDRBG) Read(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		chunk := p[:min(len(p), drbgMaxRequest)]
+		if err := d.Generate(chunk, nil, false); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
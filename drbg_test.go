@@ -0,0 +1,108 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Outputs generated by _gen/drbg.py, from the TupleHashXOF256 definitions
+// of the operations.
+func TestShakeDRBG(t *testing.T) {
+	d, err := NewShakeDRBG(ptn(48), []byte("nonce"), []byte("pers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := make([]byte, 32)
+	if err := d.Generate(out, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hex.EncodeToString(out), "3c3819f2da31b7dc2750f96f03ecb0f0f320b3d75caa3097d37f8a182b7e156f"; got != want {
+		t.Errorf("first output = %s, want %s", got, want)
+	}
+
+	out = make([]byte, 100)
+	if err := d.Generate(out, []byte("add"), false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hex.EncodeToString(out[68:]), "92f3eeafcaa9abb7c6f0b5bdc223ca8a801884a3cb98724096f33b0aa3676a68"; got != want {
+		t.Errorf("end of second output = %s, want %s", got, want)
+	}
+
+	if err := d.Reseed(ptn(32), []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	out = make([]byte, 16)
+	if _, err := d.Read(out); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hex.EncodeToString(out), "ea8d84aeb64abee152678efe96d946a6"; got != want {
+		t.Errorf("output after reseeding = %s, want %s", got, want)
+	}
+}
+
+func TestShakeDRBGPredictionResistance(t *testing.T) {
+	newDRBG := func() *ShakeDRBG {
+		d, err := NewShakeDRBG(ptn(32), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	d := newDRBG()
+	if err := d.Generate(make([]byte, 16), nil, true); err == nil {
+		t.Error("prediction resistance without an entropy source succeeded")
+	}
+
+	// With prediction resistance, Generate is Reseed from the source
+	// followed by Generate.
+	entropy := bytes.Repeat([]byte{0xaa}, 32)
+	d.SetEntropySource(bytes.NewReader(entropy))
+	got := make([]byte, 16)
+	if err := d.Generate(got, []byte("add"), true); err != nil {
+		t.Fatal(err)
+	}
+	e := newDRBG()
+	e.Reseed(entropy, []byte("add"))
+	want := make([]byte, 16)
+	e.Generate(want, nil, false)
+	if !bytes.Equal(got, want) {
+		t.Error("prediction resistance differs from an explicit reseed")
+	}
+
+	// The source is exhausted, so the next reseed fails.
+	if err := d.Generate(got, nil, true); err == nil {
+		t.Error("Generate succeeded with an exhausted entropy source")
+	}
+}
+
+func TestShakeDRBGReseedInterval(t *testing.T) {
+	d, _ := NewShakeDRBG(ptn(32), nil, nil)
+	d.reseedCounter = drbgReseedInterval + 1
+	if err := d.Generate(make([]byte, 1), nil, false); err != ErrReseedRequired {
+		t.Errorf("Generate after the reseed interval = %v", err)
+	}
+	d.Reseed(ptn(32), nil)
+	if err := d.Generate(make([]byte, 1), nil, false); err != nil {
+		t.Errorf("Generate after reseeding = %v", err)
+	}
+}
+
+func TestShakeDRBGLimits(t *testing.T) {
+	if _, err := NewShakeDRBG(make([]byte, 31), nil, nil); err == nil {
+		t.Error("accepted 31 bytes of entropy")
+	}
+	d, _ := NewShakeDRBG(ptn(32), nil, nil)
+	if err := d.Generate(make([]byte, drbgMaxRequest+1), nil, false); err == nil {
+		t.Error("accepted a request larger than the maximum")
+	}
+	if n, err := d.Read(make([]byte, 2*drbgMaxRequest+1)); err != nil || n != 2*drbgMaxRequest+1 {
+		t.Errorf("Read = %d, %v", n, err)
+	}
+}
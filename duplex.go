@@ -0,0 +1,127 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the duplex construction over Keccak-f[1600], as
+// described in "Duplexing the sponge" [1]. Each duplexing call absorbs a
+// padded input block, applies the permutation and returns a prefix of the
+// outer part of the state, which makes it a convenient primitive for
+// protocol transcripts, authenticated encryption and reseedable generators.
+//
+// [1] https://keccak.team/files/SpongeDuplex.pdf
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+// Duplex is a duplex object over Keccak-f[1600]. The zero value is not
+// usable; create one with NewDuplex. A Duplex may be copied by value, or
+// with Clone, to fork its state.
+type Duplex struct {
+	// s holds the permutation state and rate. Its buffer index is not
+	// used, as every duplexing call processes a whole block.
+	s state
+}
+
+// NewDuplex returns a duplex object with the given rate in bytes, which
+// must be between 2 and 199. The capacity is 1600 bits minus the rate, and
+// the generic security strength is half the capacity.
+func NewDuplex(rate int) *Duplex {
+	if rate < 2 || rate >= 200 {
+		panic("keccak: duplex rate must be between 2 and 199 bytes")
+	}
+	return &Duplex{s: state{rate: rate, dsbyte: dsbyteKeccak}}
+}
+
+// Rate returns the rate of the duplex object in bytes, which is the
+// maximum number of bytes a single duplexing call can return.
+func (d *Duplex) Rate() int { return d.s.rate }
+
+// MaxInputSize returns the maximum number of bytes a single duplexing call
+// can absorb, which is one less than the rate to leave room for padding.
+func (d *Duplex) MaxInputSize() int { return d.s.rate - 1 }
+
+// Duplexing absorbs in, pads it with the multi-rate padding, applies the
+// permutation and fills out with the start of the resulting outer state.
+// It panics if in is longer than MaxInputSize or out is longer than Rate.
+func (d *Duplex) Duplexing(in, out []byte) {
+	if len(in) > d.MaxInputSize() {
+		panic("keccak: duplex input larger than the maximum input size")
+	}
+	if len(out) > d.s.rate {
+		panic("keccak: duplex output larger than the rate")
+	}
+
+	d.duplexing(in, out, dsbyteKeccak)
+}
+
+// duplexing performs a duplexing call whose input is followed by the
+// domain separation bits in dsbyte, as for the sponge functions. Modes
+// that append frame bits to each block, such as SpongeWrap, merge them
+// into dsbyte along with the first bit of the padding.
+func (d *Duplex) duplexing(in, out []byte, dsbyte byte) {
+	subtle.XORBytes(d.s.a[:len(in)], d.s.a[:len(in)], in)
+	d.s.n = len(in)
+	d.s.dsbyte = dsbyte
+	d.s.padAndPermute()
+	copy(out, d.s.a[:len(out)])
+}
+
+// Absorb performs a duplexing call that absorbs in and discards the output.
+func (d *Duplex) Absorb(in []byte) {
+	d.Duplexing(in, nil)
+}
+
+// Squeeze performs a duplexing call with empty input, filling out.
+func (d *Duplex) Squeeze(out []byte) {
+	d.Duplexing(nil, out)
+}
+
+// Reset returns the duplex object to its initial, all-zero state.
+func (d *Duplex) Reset() {
+	d.s.Reset()
+}
+
+// Clone returns a copy of the duplex object in its current state.
+func (d *Duplex) Clone() *Duplex {
+	ret := *d
+	return &ret
+}
+
+const (
+	magicDuplex = "kdx\x01"
+	// magic || rate || main state
+	marshaledDuplexSize = len(magicDuplex) + 1 + 200
+)
+
+func (d *Duplex) MarshalBinary() ([]byte, error) {
+	return d.AppendBinary(make([]byte, 0, marshaledDuplexSize))
+}
+
+func (d *Duplex) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, magicDuplex...)
+	b = append(b, byte(d.s.rate))
+	b = append(b, d.s.a[:]...)
+	return b, nil
+}
+
+// UnmarshalBinary restores a duplex object marshaled by MarshalBinary. It
+// may be used on the zero value of Duplex, in which case the rate is taken
+// from the marshaled state.
+func (d *Duplex) UnmarshalBinary(b []byte) error {
+	if len(b) != marshaledDuplexSize || string(b[:len(magicDuplex)]) != magicDuplex {
+		return errors.New("keccak: invalid duplex state")
+	}
+	b = b[len(magicDuplex):]
+
+	rate := int(b[0])
+	if rate < 2 || rate >= 200 || (d.s.rate != 0 && rate != d.s.rate) {
+		return errors.New("keccak: invalid duplex state rate")
+	}
+	d.s = state{rate: rate, dsbyte: dsbyteKeccak}
+	copy(d.s.a[:], b[1:])
+	return nil
+}
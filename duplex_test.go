@@ -0,0 +1,91 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestDuplexFirstCallIsSponge(t *testing.T) {
+	// The first duplexing call on a fresh object computes the sponge
+	// function truncated to at most one block; with rate 136 and the
+	// Keccak padding that is Keccak-256.
+	d := NewDuplex(rateK512)
+	out := make([]byte, 32)
+	d.Duplexing([]byte("abc"), out)
+	want := "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"
+	if got := hex.EncodeToString(out); got != want {
+		t.Errorf("first duplexing call = %s, want %s", got, want)
+	}
+}
+
+// Outputs generated by _gen/duplex.py, an independent implementation of the
+// duplex construction.
+func TestDuplexSequence(t *testing.T) {
+	d := NewDuplex(136)
+	d.Absorb([]byte("abc"))
+
+	out := make([]byte, 136)
+	d.Squeeze(out)
+	want := "38e2d41be15d9112628a01a92f3fce0933c5177663f7533bab29363139340022db0d2648b049828b9f76b0b78ef7f82d7e1f95225f6863998532aa270efead6113a146b2be048d27fadd464957e10f7f1b39f32c27dc9ed1d2a285b650c06e465facd2e7ef974dc502e811de258b46d6b0f2c571aadb6b00e532eda21c67fa6dde31ac3c6990f9a6"
+	if got := hex.EncodeToString(out); got != want {
+		t.Errorf("second duplexing call = %s, want %s", got, want)
+	}
+
+	out = make([]byte, 16)
+	d.Duplexing(ptn(135), out)
+	want = "aa3040ee5f7c4a94a5ab96bc80a96b63"
+	if got := hex.EncodeToString(out); got != want {
+		t.Errorf("third duplexing call = %s, want %s", got, want)
+	}
+}
+
+func TestDuplexLimits(t *testing.T) {
+	d := NewDuplex(136)
+	for _, tc := range []struct {
+		name    string
+		in, out []byte
+	}{
+		{"input too long", make([]byte, 136), nil},
+		{"output too long", nil, make([]byte, 137)},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: did not panic", tc.name)
+				}
+			}()
+			d.Duplexing(tc.in, tc.out)
+		}()
+	}
+}
+
+func TestDuplexCloneAndMarshal(t *testing.T) {
+	d := NewDuplex(168)
+	d.Absorb([]byte("transcript"))
+	c := d.Clone()
+
+	data, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u Duplex
+	if err := u.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewDuplex(136).UnmarshalBinary(data); err == nil {
+		t.Error("duplex with a different rate accepted the state")
+	}
+
+	a, b, e := make([]byte, 64), make([]byte, 64), make([]byte, 64)
+	d.Squeeze(a)
+	c.Squeeze(b)
+	u.Squeeze(e)
+	if !bytes.Equal(a, b) || !bytes.Equal(a, e) {
+		t.Error("clone or unmarshaled duplex produced different output")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file exports the encoding functions of NIST SP 800-185 [1], Section
+// 2.3, for building cSHAKE-based constructions outside this package.
+//
+// [1] https://doi.org/10.6028/NIST.SP.800-185
+
+// LeftEncode returns left_encode(x): the length in bytes of the minimal
+// big-endian encoding of x, as a single byte, followed by that encoding.
+// Zero is encoded as 0x01 0x00.
+func LeftEncode(x uint64) []byte {
+	return leftEncode(x)
+}
+
+// RightEncode returns right_encode(x): the minimal big-endian encoding of
+// x followed by its length in bytes as a single byte. Zero is encoded as
+// 0x00 0x01.
+func RightEncode(x uint64) []byte {
+	return rightEncode(x)
+}
+
+// EncodeString returns encode_string(s): the length of s in bits,
+// left_encoded, followed by s itself. This makes the encoding of a sequence
+// of strings unambiguous.
+func EncodeString(s []byte) []byte {
+	return appendEncodeString(make([]byte, 0, 9+len(s)), s)
+}
+
+// Bytepad returns bytepad(data, w): w left_encoded, followed by data, and
+// padded with zeros to a multiple of w bytes. cSHAKE and KMAC use it with w
+// set to the rate so that their prefix fills whole blocks. Bytepad panics
+// if w is not positive.
+func Bytepad(data []byte, w int) []byte {
+	if w <= 0 {
+		panic("keccak: non-positive bytepad width")
+	}
+	return bytepad(data, w)
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Examples from NIST SP 800-185, Section 2.3, and the encodings of a few
+// boundary values.
+func TestEncodings(t *testing.T) {
+	for _, tc := range []struct {
+		x           uint64
+		left, right string
+	}{
+		{0, "0100", "0001"},
+		{1, "0101", "0101"},
+		{255, "01ff", "ff01"},
+		{256, "020100", "010002"},
+		{168, "01a8", "a801"},
+		{1<<64 - 1, "08ffffffffffffffff", "ffffffffffffffff08"},
+	} {
+		if got := hex.EncodeToString(LeftEncode(tc.x)); got != tc.left {
+			t.Errorf("LeftEncode(%d) = %s, want %s", tc.x, got, tc.left)
+		}
+		if got := hex.EncodeToString(RightEncode(tc.x)); got != tc.right {
+			t.Errorf("RightEncode(%d) = %s, want %s", tc.x, got, tc.right)
+		}
+	}
+
+	if got, want := hex.EncodeToString(EncodeString(nil)), "0100"; got != want {
+		t.Errorf("EncodeString(\"\") = %s, want %s", got, want)
+	}
+	if got, want := hex.EncodeToString(EncodeString([]byte("KMAC"))), "0120"+hex.EncodeToString([]byte("KMAC")); got != want {
+		t.Errorf("EncodeString(\"KMAC\") = %s, want %s", got, want)
+	}
+}
+
+func TestBytepad(t *testing.T) {
+	got := Bytepad(EncodeString([]byte("Email Signature")), 168)
+	if len(got) != 168 {
+		t.Fatalf("len(Bytepad) = %d, want 168", len(got))
+	}
+	want := append([]byte{0x01, 0xa8, 0x01, 0x78}, "Email Signature"...)
+	if !bytes.Equal(got[:len(want)], want) || !bytes.Equal(got[len(want):], make([]byte, 168-len(want))) {
+		t.Errorf("Bytepad = %x", got)
+	}
+
+	// Data filling the block exactly is not padded further.
+	if got := Bytepad(make([]byte, 6), 8); len(got) != 8 {
+		t.Errorf("len(Bytepad(6 bytes, 8)) = %d, want 8", len(got))
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file computes the node identifiers of the Ethereum Name Service,
+// which EIP-137 defines by hashing the labels of a name from the top-level
+// domain down.
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// LabelHash returns the ENS labelhash of a single label of a name, the
+// Keccak-256 digest of the label.
+func LabelHash(label string) [32]byte {
+	return Sum256([]byte(label))
+}
+
+// Namehash returns the ENS namehash of name, as EIP-137 defines it: the
+// zero hash for the empty name, and otherwise the HashPair of the namehash
+// of its parent and the labelhash of its first label. A label written as
+// 64 hexadecimal digits in brackets, as ENS tools show labels whose text is
+// unknown, is taken as its labelhash.
+//
+// The name must already be normalized, since names that differ only in case
+// or in Unicode normalization have different namehashes. Namehash does not
+// normalize it; NamehashNormalized does.
+func Namehash(name string) (node [32]byte) {
+	if name == "" {
+		return
+	}
+	for {
+		i := strings.LastIndexByte(name, '.')
+		node = HashPair(node, labelHash(name[i+1:]))
+		if i < 0 {
+			return
+		}
+		name = name[:i]
+	}
+}
+
+// labelHash is LabelHash, but takes a label of the form [hash] as its
+// labelhash.
+func labelHash(label string) [32]byte {
+	if len(label) == 66 && label[0] == '[' && label[65] == ']' {
+		var hash [32]byte
+		if _, err := hex.Decode(hash[:], []byte(label[1:65])); err == nil {
+			return hash
+		}
+	}
+	return LabelHash(label)
+}
+
+// NamehashNormalized normalizes name with normalize, and returns the
+// Namehash of the result. The normalization of ENS names is ENSIP-15, an
+// extension of UTS-46 that takes Unicode tables this package does not
+// include, so the caller provides it, such as from an ENSIP-15 library.
+// With a nil normalize, NamehashNormalized uses NormalizeNameLite.
+func NamehashNormalized(name string, normalize func(string) (string, error)) ([32]byte, error) {
+	if normalize == nil {
+		normalize = NormalizeNameLite
+	}
+	name, err := normalize(name)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return Namehash(name), nil
+}
+
+// NormalizeNameLite is a minimal normalization of ENS names, for names that
+// are ASCII or whose Unicode is already normalized: it maps letters to
+// lowercase and rejects empty labels and labels with spaces or control
+// characters. Unlike ENSIP-15, it does not map or reject emoji, confusable
+// characters or compatibility forms, so names from untrusted input should
+// be normalized by a full implementation.
+func NormalizeNameLite(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return "", fmt.Errorf("keccak: ENS name %q has an empty label", name)
+		}
+	}
+	if i := strings.IndexFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r) || r == unicode.ReplacementChar
+	}); i >= 0 {
+		return "", fmt.Errorf("keccak: ENS name %q has an invalid character at byte %d", name, i)
+	}
+	return strings.ToLower(name), nil
+}
+
+var errDNSName = errors.New("keccak: invalid DNS-encoded name")
+
+// DNSEncodeName returns name in the DNS wire format that ENSIP-10 wildcard
+// resolution passes to resolvers: each label preceded by its length in a
+// byte, and a zero byte at the end. It returns an error if name has an
+// empty label or a label longer than 255 bytes.
+func DNSEncodeName(name string) ([]byte, error) {
+	encoded := make([]byte, 0, len(name)+2)
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if label == "" || len(label) > 255 {
+				return nil, fmt.Errorf("keccak: cannot DNS-encode the ENS name %q", name)
+			}
+			encoded = append(encoded, byte(len(label)))
+			encoded = append(encoded, label...)
+		}
+	}
+	return append(encoded, 0), nil
+}
+
+// DNSNamehash returns the Namehash of a name in the DNS wire format, as
+// returned by DNSEncodeName, without decoding it to text first. It returns
+// an error if the encoding does not end with the zero byte right after its
+// last label.
+func DNSNamehash(encoded []byte) ([32]byte, error) {
+	// Find the labels first, as the namehash starts from the last one.
+	var labels [][]byte
+	for {
+		if len(encoded) == 0 {
+			return [32]byte{}, errDNSName
+		}
+		n := int(encoded[0])
+		if n == 0 {
+			break
+		}
+		if len(encoded) < 1+n {
+			return [32]byte{}, errDNSName
+		}
+		labels = append(labels, encoded[1:1+n])
+		encoded = encoded[1+n:]
+	}
+	if len(encoded) != 1 {
+		return [32]byte{}, errDNSName
+	}
+	var node [32]byte
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = HashPair(node, labelHash(string(labels[i])))
+	}
+	return node, nil
+}
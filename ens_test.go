@@ -0,0 +1,93 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNamehash(t *testing.T) {
+	// The examples of EIP-137.
+	for _, tc := range []struct{ name, want string }{
+		{"", "0000000000000000000000000000000000000000000000000000000000000000"},
+		{"eth", "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae"},
+		{"foo.eth", "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f"},
+	} {
+		got := Namehash(tc.name)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("Namehash(%q) = %x, want %s", tc.name, got, tc.want)
+		}
+
+		encoded, err := DNSEncodeName(tc.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := DNSNamehash(encoded); err != nil || hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("DNSNamehash(%x) = %x, %v, want %s", encoded, got, err, tc.want)
+		}
+	}
+
+	eth := LabelHash("eth")
+	if got, want := hex.EncodeToString(eth[:]), "4f5b812789fc606be1b3b16908db13fc7a9adf7ca72641f84d75b47069d3d7f0"; got != want {
+		t.Errorf("LabelHash(eth) = %s, want %s", got, want)
+	}
+	// Labels can be given by their labelhash.
+	if got, want := Namehash("foo.["+hex.EncodeToString(eth[:])+"]"), Namehash("foo.eth"); got != want {
+		t.Errorf("Namehash with a bracketed labelhash = %x, want %x", got, want)
+	}
+	if got, want := Namehash("[xyz]"), HashPair([32]byte{}, LabelHash("[xyz]")); got != want {
+		t.Errorf("Namehash([xyz]) = %x, want %x, the hash of the label as text", got, want)
+	}
+}
+
+func TestNamehashNormalized(t *testing.T) {
+	want := Namehash("foo.eth")
+	if got, err := NamehashNormalized("Foo.ETH", nil); err != nil || got != want {
+		t.Errorf("NamehashNormalized(Foo.ETH) = %x, %v, want %x", got, err, want)
+	}
+	for _, name := range []string{"foo..eth", ".eth", "eth.", "foo bar.eth", "foo\x00.eth", "\xff.eth"} {
+		if _, err := NamehashNormalized(name, nil); err == nil {
+			t.Errorf("NamehashNormalized(%q) succeeded", name)
+		}
+	}
+
+	errBad := errors.New("bad name")
+	normalize := func(name string) (string, error) {
+		if strings.HasPrefix(name, "bad") {
+			return "", errBad
+		}
+		return strings.TrimSuffix(name, "!"), nil
+	}
+	if got, err := NamehashNormalized("foo.eth!", normalize); err != nil || got != want {
+		t.Errorf("NamehashNormalized with a custom normalization = %x, %v, want %x", got, err, want)
+	}
+	if _, err := NamehashNormalized("bad.eth", normalize); err != errBad {
+		t.Errorf("NamehashNormalized with a failing normalization = %v, want %v", err, errBad)
+	}
+}
+
+func TestDNSEncodeName(t *testing.T) {
+	if got, err := DNSEncodeName("foo.eth"); err != nil || string(got) != "\x03foo\x03eth\x00" {
+		t.Errorf("DNSEncodeName(foo.eth) = %q, %v", got, err)
+	}
+	long := strings.Repeat("a", 255)
+	if _, err := DNSEncodeName(long + ".eth"); err != nil {
+		t.Errorf("DNSEncodeName of a 255-byte label: %v", err)
+	}
+	for _, name := range []string{"foo..eth", ".eth", "eth.", long + "a.eth"} {
+		if _, err := DNSEncodeName(name); err == nil {
+			t.Errorf("DNSEncodeName(%q) succeeded", name)
+		}
+	}
+
+	for _, encoded := range []string{"", "\x03foo", "\x03fo", "\x03foo\x03eth", "\x03foo\x00\x00", "\x05foo\x00"} {
+		if _, err := DNSNamehash([]byte(encoded)); err == nil {
+			t.Errorf("DNSNamehash(%q) succeeded", encoded)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "fmt"
+
+// ErrorSelector returns the 4-byte selector of a Solidity custom error,
+// such as "InsufficientBalance(uint256 available, uint256 required)", which
+// is the start of the revert data of a call that reverts with it. Errors
+// are identified as functions are, so ErrorSelector is Selector, and panics
+// likewise if the signature is invalid.
+func ErrorSelector(signature string) [4]byte {
+	return Selector(signature)
+}
+
+// The errors with which Solidity reverts on require and assert failures,
+// which every ErrorTable knows.
+var (
+	errorStringSelector = Selector("Error(string)")
+	panicSelector       = Selector("Panic(uint256)")
+)
+
+// ErrorTable maps the selectors at the start of revert data to the
+// signatures of the custom errors registered with it, to decode the
+// reason a call reverted. It also knows Error(string) and Panic(uint256),
+// with which require and assert revert. The zero value is ready to use.
+type ErrorTable struct {
+	errors map[[4]byte]string
+}
+
+// Register adds the custom error with the given signature to the table,
+// under its canonical signature. It returns an error if the signature is
+// invalid, or if another error with the same selector is registered.
+func (t *ErrorTable) Register(signature string) error {
+	canonical, err := CanonicalSignature(signature)
+	if err != nil {
+		return err
+	}
+	selector := Selector(canonical)
+	if old, ok := t.Lookup(selector[:]); ok && old != canonical {
+		return fmt.Errorf("keccak: error %s has the selector %x of %s", canonical, selector, old)
+	}
+	if t.errors == nil {
+		t.errors = make(map[[4]byte]string)
+	}
+	t.errors[selector] = canonical
+	return nil
+}
+
+// Lookup returns the canonical signature of the error whose selector
+// starts revertData, and whether it is registered. Revert data shorter
+// than a selector, such as that of a bare revert, matches no error.
+func (t *ErrorTable) Lookup(revertData []byte) (signature string, ok bool) {
+	if len(revertData) < 4 {
+		return "", false
+	}
+	selector := [4]byte(revertData)
+	switch selector {
+	case errorStringSelector:
+		return "Error(string)", true
+	case panicSelector:
+		return "Panic(uint256)", true
+	}
+	signature, ok = t.errors[selector]
+	return
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestErrorSelector(t *testing.T) {
+	for _, tc := range []struct{ sig, want string }{
+		{"Error(string)", "08c379a0"},
+		{"Panic(uint256)", "4e487b71"},
+		{"Panic(uint code)", "4e487b71"},
+	} {
+		got := ErrorSelector(tc.sig)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("ErrorSelector(%q) = %x, want %s", tc.sig, got, tc.want)
+		}
+	}
+}
+
+func TestErrorTable(t *testing.T) {
+	var table ErrorTable
+	revert := func(sig string) []byte {
+		s := ErrorSelector(sig)
+		return append(s[:], make([]byte, 64)...)
+	}
+
+	// Error and Panic are known to the zero value.
+	if sig, ok := table.Lookup(revert("Error(string)")); !ok || sig != "Error(string)" {
+		t.Errorf("Lookup of Error(string) = %q, %v", sig, ok)
+	}
+	if sig, ok := table.Lookup(revert("Panic(uint256)")); !ok || sig != "Panic(uint256)" {
+		t.Errorf("Lookup of Panic(uint256) = %q, %v", sig, ok)
+	}
+
+	const insufficient = "InsufficientBalance(uint256,uint256)"
+	if sig, ok := table.Lookup(revert(insufficient)); ok {
+		t.Errorf("Lookup of an unregistered error = %q", sig)
+	}
+	if err := table.Register("InsufficientBalance(uint256 available, uint256 required)"); err != nil {
+		t.Fatal(err)
+	}
+	if sig, ok := table.Lookup(revert(insufficient)); !ok || sig != insufficient {
+		t.Errorf("Lookup of a registered error = %q, %v, want %q", sig, ok, insufficient)
+	}
+	// Registering the same error again is harmless.
+	if err := table.Register(insufficient); err != nil {
+		t.Errorf("registering an error twice: %v", err)
+	}
+
+	for _, data := range [][]byte{nil, {0x08, 0xc3, 0x79}} {
+		if sig, ok := table.Lookup(data); ok {
+			t.Errorf("Lookup(%x) = %q", data, sig)
+		}
+	}
+
+	// burn(uint256) and collate_propagate_storage(bytes16) share 42966c68.
+	if err := table.Register("burn(uint256)"); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Register("collate_propagate_storage(bytes16)"); err == nil {
+		t.Error("registering an error with the selector of another succeeded")
+	}
+	if err := table.Register("Bad(uint7)"); err == nil {
+		t.Error("registering an invalid signature succeeded")
+	}
+}
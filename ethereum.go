@@ -0,0 +1,109 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the hashing helpers of go-ethereum's crypto package,
+// with the same signatures, so that code moving off go-ethereum only has
+// to change its imports.
+
+import (
+	"encoding/hex"
+	"hash"
+)
+
+// Keccak256 returns the legacy Keccak-256 digest of the concatenation of
+// data, as go-ethereum's crypto.Keccak256 does. The slices are absorbed in
+// order, without being copied together first.
+func Keccak256(data ...[]byte) []byte {
+	d := state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	for _, b := range data {
+		d.Write(b)
+	}
+	digest := make([]byte, 32)
+	d.Read(digest)
+	return digest
+}
+
+// Keccak512 returns the legacy Keccak-512 digest of the concatenation of
+// data, as go-ethereum's crypto.Keccak512 does.
+func Keccak512(data ...[]byte) []byte {
+	d := state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteKeccak}
+	for _, b := range data {
+		d.Write(b)
+	}
+	digest := make([]byte, 64)
+	d.Read(digest)
+	return digest
+}
+
+// HashLength is the size of a Hash in bytes.
+const HashLength = 32
+
+// Hash is a Keccak-256 digest, as go-ethereum's common.Hash. Unlike the
+// []byte returned by Keccak256, it can be used as a map key and compared
+// with ==, and is returned without allocating.
+type Hash [HashLength]byte
+
+// Bytes returns the digest as a byte slice.
+func (h Hash) Bytes() []byte { return h[:] }
+
+// Hex returns the digest in lowercase hexadecimal with a 0x prefix.
+func (h Hash) Hex() string {
+	var buf [2 + 2*HashLength]byte
+	copy(buf[:], "0x")
+	hex.Encode(buf[2:], h[:])
+	return string(buf[:])
+}
+
+// String returns the digest as Hex does, to implement fmt.Stringer.
+func (h Hash) String() string { return h.Hex() }
+
+// Keccak256Hash returns the legacy Keccak-256 digest of the concatenation
+// of data as a Hash, as go-ethereum's crypto.Keccak256Hash does. It does
+// not allocate.
+func Keccak256Hash(data ...[]byte) (h Hash) {
+	d := state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	for _, b := range data {
+		d.Write(b)
+	}
+	d.Read(h[:])
+	return
+}
+
+// KeccakState is a hash from which the digest can also be read, as
+// go-ethereum's crypto.KeccakState. Reading the digest skips the copy of
+// the state that Sum makes, but the hash must then be reset before it is
+// written to again. Every hash returned by the constructors of this package
+// implements it, including NewLegacyKeccak256 and NewLegacyKeccak512.
+type KeccakState interface {
+	hash.Hash
+	Read([]byte) (int, error)
+}
+
+// NewKeccakState returns a new legacy Keccak-256 hash, as go-ethereum's
+// crypto.NewKeccakState does.
+func NewKeccakState() KeccakState {
+	return newState(state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak})
+}
+
+// HashData resets kh, which must be a Keccak-256 hash, absorbs data and
+// reads the digest, as go-ethereum's crypto.HashData does. Reusing kh
+// saves the allocation of a new hash for each digest, and HashData does
+// not allocate at all if kh is a hash of this package.
+func HashData(kh KeccakState, data []byte) (h Hash) {
+	if d, ok := kh.(*state); ok {
+		d.Reset()
+		d.Write(data)
+		d.Read(h[:])
+		return
+	}
+	// The digest escapes through the interface call, so it is read into
+	// a separate buffer rather than h.
+	digest := make([]byte, HashLength)
+	kh.Reset()
+	kh.Write(data)
+	kh.Read(digest)
+	return Hash(digest)
+}
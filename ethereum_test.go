@@ -0,0 +1,102 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestKeccak256(t *testing.T) {
+	empty, _ := hex.DecodeString("c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470")
+	if got := Keccak256(); !bytes.Equal(got, empty) {
+		t.Errorf("Keccak256() = %x, want %x", got, empty)
+	}
+	msg := ptn(500)
+	for _, split := range [][]int{{0}, {500}, {1, 499}, {136, 136, 0, 228}, {7, 100, 200, 193}} {
+		var parts [][]byte
+		rest := msg
+		for _, n := range split {
+			parts, rest = append(parts, rest[:n]), rest[n:]
+		}
+		all := msg[:len(msg)-len(rest)]
+		if got, want := Keccak256(parts...), Sum256(all); !bytes.Equal(got, want[:]) {
+			t.Errorf("Keccak256 of parts %v = %x, want %x", split, got, want)
+		}
+	}
+	if n := testing.AllocsPerRun(10, func() { Keccak256(msg[:10], msg[10:]) }); n > 1 {
+		t.Errorf("Keccak256 allocated %v times, want 1", n)
+	}
+}
+
+func TestKeccak512(t *testing.T) {
+	empty, _ := hex.DecodeString("0eab42de4c3ceb9235fc91acffe746b29c29a8c366b7c60e4e67c466f36a4304c00fa9caf9d87976ba469bcbe06713b435f091ef2769fb160cdab33d3670680e")
+	if got := Keccak512(); !bytes.Equal(got, empty) {
+		t.Errorf("Keccak512() = %x, want %x", got, empty)
+	}
+	msg := ptn(500)
+	for _, split := range [][]int{{0}, {500}, {1, 499}, {72, 72, 0, 356}, {7, 100, 200, 193}} {
+		var parts [][]byte
+		rest := msg
+		for _, n := range split {
+			parts, rest = append(parts, rest[:n]), rest[n:]
+		}
+		all := msg[:len(msg)-len(rest)]
+		if got, want := Keccak512(parts...), Sum512(all); !bytes.Equal(got, want[:]) {
+			t.Errorf("Keccak512 of parts %v = %x, want %x", split, got, want)
+		}
+	}
+}
+
+func TestKeccak256Hash(t *testing.T) {
+	h := Keccak256Hash()
+	if got, want := h.Hex(), "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"; got != want {
+		t.Errorf("Keccak256Hash().Hex() = %s, want %s", got, want)
+	}
+	if got := fmt.Sprint(h); got != h.Hex() {
+		t.Errorf("fmt.Sprint(Keccak256Hash()) = %s, want %s", got, h.Hex())
+	}
+	msg := ptn(300)
+	h = Keccak256Hash(msg[:100], msg[100:])
+	if want := Keccak256(msg); !bytes.Equal(h.Bytes(), want) {
+		t.Errorf("Keccak256Hash = %x, want %x", h, want)
+	}
+	// Hashes are comparable map keys.
+	seen := map[Hash]bool{h: true}
+	if !seen[Keccak256Hash(msg)] || seen[Keccak256Hash(msg[1:])] {
+		t.Error("Hash map lookup failed")
+	}
+	if n := testing.AllocsPerRun(10, func() { Keccak256Hash(msg[:10], msg[10:]) }); n > 0 {
+		t.Errorf("Keccak256Hash allocated %v times, want 0", n)
+	}
+}
+
+var (
+	_ KeccakState = NewLegacyKeccak256().(KeccakState)
+	_ KeccakState = NewLegacyKeccak512().(KeccakState)
+	_ KeccakState = ShakeHash(nil)
+)
+
+func TestKeccakState(t *testing.T) {
+	kh := NewKeccakState()
+	// A hash from a template of the initial state takes the generic path.
+	other := NewFromTemplate(NewTemplate(NewLegacyKeccak256()))
+	for _, n := range []int{0, 32, 136, 500} {
+		msg := ptn(n)
+		want := Keccak256Hash(msg)
+		if got := HashData(kh, msg); got != want {
+			t.Errorf("HashData(%d bytes) = %x, want %x", n, got, want)
+		}
+		if got := HashData(other, msg); got != want {
+			t.Errorf("HashData(template hash, %d bytes) = %x, want %x", n, got, want)
+		}
+	}
+	msg := ptn(64)
+	if n := testing.AllocsPerRun(10, func() { HashData(kh, msg) }); n > 0 {
+		t.Errorf("HashData allocated %v times, want 0", n)
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !keccak_experimental
+
+package keccak
+
+// Without the keccak_experimental tag, the assembly that has only been run
+// in emulators is left unused, and the pure-Go permutations are used in its
+// place.
+
+const experimental = false
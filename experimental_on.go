@@ -0,0 +1,14 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_experimental
+
+package keccak
+
+// The keccak_experimental build tag enables the assembly that has only
+// been run in emulators, never on the hardware it is written for: NEON on
+// arm, VSX on ppc64le, Zbb and RVV on riscv64, and the scalar and LSX code
+// on loong64.
+
+const experimental = true
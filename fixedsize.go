@@ -0,0 +1,71 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file hashes the two input sizes that dominate blockchain workloads,
+// 32-byte hashes and keys and 64-byte pairs of them, with the lanes of the
+// single padded block loaded straight from the input. Sum256 dispatches to
+// them.
+
+import "encoding/binary"
+
+// Keccak256Of32 returns the legacy Keccak-256 digest of a 32-byte value,
+// such as a hash or a storage key. It equals Sum256(data[:]), with no
+// buffering or length checks.
+func Keccak256Of32(data [32]byte) [32]byte {
+	if useKIMD {
+		return sumBlock256(data[:])
+	}
+	var a [25]uint64
+	a[0] = binary.LittleEndian.Uint64(data[0:])
+	a[1] = binary.LittleEndian.Uint64(data[8:])
+	a[2] = binary.LittleEndian.Uint64(data[16:])
+	a[3] = binary.LittleEndian.Uint64(data[24:])
+	a[4] = dsbyteKeccak
+	a[rateK512/8-1] = 0x80 << 56
+	return permuteBlock256(&a, 32)
+}
+
+// Keccak256Of64 returns the legacy Keccak-256 digest of a 64-byte value,
+// such as two concatenated hashes. It equals Sum256(data[:]), with no
+// buffering or length checks.
+func Keccak256Of64(data [64]byte) [32]byte {
+	if useKIMD {
+		return sumBlock256(data[:])
+	}
+	var a [25]uint64
+	a[0] = binary.LittleEndian.Uint64(data[0:])
+	a[1] = binary.LittleEndian.Uint64(data[8:])
+	a[2] = binary.LittleEndian.Uint64(data[16:])
+	a[3] = binary.LittleEndian.Uint64(data[24:])
+	a[4] = binary.LittleEndian.Uint64(data[32:])
+	a[5] = binary.LittleEndian.Uint64(data[40:])
+	a[6] = binary.LittleEndian.Uint64(data[48:])
+	a[7] = binary.LittleEndian.Uint64(data[56:])
+	a[8] = dsbyteKeccak
+	a[rateK512/8-1] = 0x80 << 56
+	return permuteBlock256(&a, 64)
+}
+
+// permuteBlock256 permutes a, which holds a padded Keccak-256 block of n
+// bytes of input, and returns the digest in its first four lanes.
+func permuteBlock256(a *[25]uint64, n int) (digest [32]byte) {
+	keccakF1600(a)
+	binary.LittleEndian.PutUint64(digest[0:], a[0])
+	binary.LittleEndian.PutUint64(digest[8:], a[1])
+	binary.LittleEndian.PutUint64(digest[16:], a[2])
+	binary.LittleEndian.PutUint64(digest[24:], a[3])
+	countPermutations(1)
+	countAbsorbed(n)
+	countSqueezed(32)
+	return
+}
+
+// sumBlock256 is the Keccak-256 of sumBlock, for platforms where KIMD
+// permutes the state in its byte order faster than lanes can be loaded.
+func sumBlock256(data []byte) (digest [32]byte) {
+	sumBlock(digest[:], data, rateK512)
+	return
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestKeccak256Fixed(t *testing.T) {
+	msg := ptn(64 + 20)
+	for i := range 20 {
+		want := func(data []byte) []byte {
+			h := NewLegacyKeccak256()
+			h.Write(data)
+			return h.Sum(nil)
+		}
+		v32, v64 := [32]byte(msg[i:]), [64]byte(msg[i:])
+		if got := Keccak256Of32(v32); !bytes.Equal(got[:], want(v32[:])) {
+			t.Errorf("Keccak256Of32(%x) = %x, want %x", v32, got, want(v32[:]))
+		}
+		if got := Keccak256Of64(v64); !bytes.Equal(got[:], want(v64[:])) {
+			t.Errorf("Keccak256Of64(%x) = %x, want %x", v64, got, want(v64[:]))
+		}
+		// Sum256 dispatches to them.
+		if got := Sum256(v32[:]); !bytes.Equal(got[:], want(v32[:])) {
+			t.Errorf("Sum256(%x) = %x, want %x", v32, got, want(v32[:]))
+		}
+		if got := Sum256(v64[:]); !bytes.Equal(got[:], want(v64[:])) {
+			t.Errorf("Sum256(%x) = %x, want %x", v64, got, want(v64[:]))
+		}
+	}
+}
+
+func BenchmarkKeccak256Of32(b *testing.B) {
+	var v [32]byte
+	b.SetBytes(32)
+	for i := 0; i < b.N; i++ {
+		v = Keccak256Of32(v)
+	}
+}
+
+func BenchmarkKeccak256Of64(b *testing.B) {
+	var v [64]byte
+	b.SetBytes(64)
+	for i := 0; i < b.N; i++ {
+		d := Keccak256Of64(v)
+		copy(v[:], d[:])
+	}
+}
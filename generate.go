@@ -0,0 +1,27 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// The assembly files are generated by the programs in _asm, a module of its
+// own so that this one has no dependencies: the amd64 ones are avo programs,
+// and those for the other architectures, which avo does not support, print
+// the assembly themselves. Each runs in its own directory. Run go generate
+// after changing one of them; TestGenerated fails when a committed file is
+// out of date.
+
+//go:generate go run -C _asm/scalar . -out ../../keccakf_amd64.s
+//go:generate go run -C _asm/bmi2 . -out ../../keccakf_bmi2_amd64.s
+//go:generate go run -C _asm/avx512 . -out ../../keccakf_avx512_amd64.s
+//go:generate go run -C _asm/avx512 . -lanes 8 -out ../../keccakf_x8_avx512_amd64.s
+//go:generate go run -C _asm/avx2x4 . -out ../../keccakf_x4_avx2_amd64.s
+//go:generate go run -C _asm/sse2 . -out ../../keccakf_sse2_386.s
+//go:generate go run -C _asm/sse2x2 . -out ../../keccakf_x2_sse2_386.s
+//go:generate go run -C _asm/neon . -out ../../keccakf_neon_arm.s
+//go:generate go run -C _asm/vsxx2 . -out ../../keccakf_x2_vsx_ppc64le.s
+//go:generate go run -C _asm/rvv . -out ../../keccakf_rvv_riscv64.s
+//go:generate go run -C _asm/zbb . -out ../../keccakf_zbb_riscv64.s
+//go:generate go run -C _asm/loong64 . -out ../../keccakf_scalar_loong64.s
+//go:generate go run -C _asm/lsxx2 . -out ../../keccakf_x2_lsx_loong64.s
+//go:generate go run -C _asm/simd128 . -out ../../keccakf_x2_simd128_wasm.s
@@ -0,0 +1,99 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var generated = flag.Bool("generated", false, "run the generators of the assembly and check their output")
+
+// TestGenerated runs each go:generate command of generate.go and checks
+// that its output matches the committed file. It downloads the
+// dependencies of the generators and builds them all, so it only runs with
+// the -generated flag.
+func TestGenerated(t *testing.T) {
+	if !*generated {
+		t.Skip("run with -generated to check the generated assembly")
+	}
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	// The generators are built for the machine running the test, whatever
+	// GOOS and GOARCH the test itself was built for.
+	b, err := exec.Command(goCmd, "env", "GOHOSTOS", "GOHOSTARCH").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := strings.Fields(string(b))
+	if len(host) != 2 {
+		t.Fatalf("go env GOHOSTOS GOHOSTARCH printed %q", b)
+	}
+	env := append(os.Environ(), "GOOS="+host[0], "GOARCH="+host[1])
+	// The generators need avo, which may not be in the module cache.
+	download := exec.Command(goCmd, "mod", "download", "-C", "_asm")
+	download.Env = env
+	if b, err := download.CombinedOutput(); err != nil {
+		t.Skipf("downloading the dependencies of the generators: %v\n%s", err, b)
+	}
+	src, err := os.ReadFile("generate.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp := t.TempDir()
+	n := 0
+	for _, line := range strings.Split(string(src), "\n") {
+		cmd, ok := strings.CutPrefix(line, "//go:generate go run -C ")
+		if !ok {
+			continue
+		}
+		n++
+		args := strings.Fields(cmd)
+		dir, args := args[0], args[2:]
+		out := args[len(args)-1]
+		name := filepath.Base(out)
+		t.Run(name, func(t *testing.T) {
+			// The generators write the output file named on their command
+			// line into their header, so they are run from a temporary
+			// directory at the same depth as their own.
+			bin := filepath.Join(tmp, filepath.Base(dir))
+			build := exec.Command(goCmd, "build", "-C", dir, "-o", bin, ".")
+			build.Env = env
+			if b, err := build.CombinedOutput(); err != nil {
+				t.Fatalf("building %s: %v\n%s", dir, err, b)
+			}
+			genDir := filepath.Join(tmp, dir)
+			if err := os.MkdirAll(genDir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			gen := exec.Command(bin, args...)
+			gen.Dir = genDir
+			if b, err := gen.CombinedOutput(); err != nil {
+				t.Fatalf("running %s: %v\n%s", dir, err, b)
+			}
+			got, err := os.ReadFile(filepath.Join(genDir, out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(filepath.Join(dir, out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s is out of date; run go generate", name)
+			}
+		})
+	}
+	if n == 0 {
+		t.Fatal("no go:generate commands found")
+	}
+}
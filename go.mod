@@ -1,3 +1,3 @@
 module github.com/filecoin-project/go-keccak
 
-go 1.24
+go 1.25
@@ -0,0 +1,23 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gpu hashes very large batches of messages, such as the leaves of
+// a sector commitment, on a GPU. It is experimental.
+//
+// The GPU is programmed with OpenCL, which the drivers of NVIDIA, AMD and
+// Intel GPUs all implement, so there is no separate CUDA binding. The
+// OpenCL code is only built with the keccak_opencl build tag and cgo, and
+// it needs the headers of OpenCL and its ICD loader, such as those of the
+// ocl-icd-opencl-dev package of Debian, in the search paths of the C
+// compiler, or else
+//
+//	CGO_CFLAGS=-I$OPENCL/include
+//	CGO_LDFLAGS=-L$OPENCL/lib
+//
+// On macOS, the OpenCL framework is used.
+//
+// Without the tag, without cgo, or on a machine without a GPU, and for
+// batches too small to be worth copying to the GPU, HashBatch256 returns
+// the digests of the CPU multi-buffer code of the keccak package.
+package gpu
@@ -0,0 +1,33 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gpu
+
+import keccak "github.com/filecoin-project/go-keccak"
+
+// minBatch is the number of messages below which a batch is hashed on the
+// CPU, where it takes less time than copying the messages to the GPU and
+// the digests back. It is a guess: the OpenCL code was not timed on a GPU.
+var minBatch = 1 << 16
+
+// HashBatch256 returns the legacy Keccak-256 digests of msgs, in order, as
+// keccak.HashBatch256 computes them.
+//
+// Large batches are hashed on the GPU when the package is built with the
+// keccak_opencl tag and the machine has one. Otherwise, or if the GPU
+// fails, the batch is hashed by keccak.HashBatch256.
+func HashBatch256(msgs [][]byte) [][32]byte {
+	if len(msgs) >= minBatch && available() {
+		digests := make([][32]byte, len(msgs))
+		if hashBatch256(msgs, digests) {
+			return digests
+		}
+	}
+	return keccak.HashBatch256(msgs)
+}
+
+// Available reports whether HashBatch256 hashes large batches on a GPU.
+func Available() bool {
+	return available()
+}
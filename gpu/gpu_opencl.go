@@ -0,0 +1,157 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_opencl && cgo
+
+package gpu
+
+/*
+#cgo !darwin LDFLAGS: -lOpenCL
+#cgo darwin LDFLAGS: -framework OpenCL
+#define CL_TARGET_OPENCL_VERSION 120
+#ifdef __APPLE__
+#include <OpenCL/opencl.h>
+#else
+#include <CL/cl.h>
+#endif
+#include <stdlib.h>
+
+static cl_context ctx;
+static cl_command_queue queue;
+static cl_kernel kernel;
+
+// keccakGPUInit builds the kernel for the first GPU found.
+static cl_int keccakGPUInit(const char *src, size_t len) {
+	cl_platform_id platforms[16];
+	cl_uint n;
+	cl_device_id dev;
+	cl_program prog;
+	cl_int err = clGetPlatformIDs(16, platforms, &n);
+	if (err != CL_SUCCESS) {
+		return err;
+	}
+	err = CL_DEVICE_NOT_FOUND;
+	for (cl_uint i = 0; i < n && i < 16 && err != CL_SUCCESS; i++) {
+		err = clGetDeviceIDs(platforms[i], CL_DEVICE_TYPE_GPU, 1, &dev, NULL);
+	}
+	if (err != CL_SUCCESS) {
+		return err;
+	}
+	ctx = clCreateContext(NULL, 1, &dev, NULL, NULL, &err);
+	if (err != CL_SUCCESS) {
+		return err;
+	}
+	queue = clCreateCommandQueue(ctx, dev, 0, &err);
+	if (err != CL_SUCCESS) {
+		goto context;
+	}
+	prog = clCreateProgramWithSource(ctx, 1, &src, &len, &err);
+	if (err != CL_SUCCESS) {
+		goto queue;
+	}
+	err = clBuildProgram(prog, 1, &dev, "", NULL, NULL);
+	if (err == CL_SUCCESS) {
+		kernel = clCreateKernel(prog, "keccak256", &err);
+	}
+	clReleaseProgram(prog);
+	if (err == CL_SUCCESS) {
+		return CL_SUCCESS;
+	}
+queue:
+	clReleaseCommandQueue(queue);
+context:
+	clReleaseContext(ctx);
+	return err;
+}
+
+// keccakGPUHash writes to out the digests of the n messages concatenated
+// in data, message i running from off[i] to off[i+1].
+static cl_int keccakGPUHash(const unsigned char *data, size_t len, const cl_ulong *off, size_t n, unsigned char *out) {
+	cl_int err;
+	cl_mem in = NULL, offsets = NULL, digests = NULL;
+	in = clCreateBuffer(ctx, CL_MEM_READ_ONLY | CL_MEM_COPY_HOST_PTR, len, (void *)data, &err);
+	if (err != CL_SUCCESS) {
+		goto done;
+	}
+	offsets = clCreateBuffer(ctx, CL_MEM_READ_ONLY | CL_MEM_COPY_HOST_PTR, (n + 1) * sizeof(cl_ulong), (void *)off, &err);
+	if (err != CL_SUCCESS) {
+		goto done;
+	}
+	digests = clCreateBuffer(ctx, CL_MEM_WRITE_ONLY, 32 * n, NULL, &err);
+	if (err != CL_SUCCESS) {
+		goto done;
+	}
+	if ((err = clSetKernelArg(kernel, 0, sizeof(cl_mem), &in)) != CL_SUCCESS ||
+	    (err = clSetKernelArg(kernel, 1, sizeof(cl_mem), &offsets)) != CL_SUCCESS ||
+	    (err = clSetKernelArg(kernel, 2, sizeof(cl_mem), &digests)) != CL_SUCCESS) {
+		goto done;
+	}
+	err = clEnqueueNDRangeKernel(queue, kernel, 1, NULL, &n, NULL, 0, NULL, NULL);
+	if (err != CL_SUCCESS) {
+		goto done;
+	}
+	err = clEnqueueReadBuffer(queue, digests, CL_TRUE, 0, 32 * n, out, 0, NULL, NULL);
+done:
+	if (digests != NULL) {
+		clReleaseMemObject(digests);
+	}
+	if (offsets != NULL) {
+		clReleaseMemObject(offsets);
+	}
+	if (in != NULL) {
+		clReleaseMemObject(in);
+	}
+	return err;
+}
+*/
+import "C"
+
+import (
+	_ "embed"
+	"sync"
+	"unsafe"
+)
+
+//go:embed keccak.cl
+var kernelSource string
+
+var (
+	initOnce sync.Once
+	ready    bool
+
+	// mu serializes the batches, which share the arguments of the kernel.
+	mu sync.Mutex
+)
+
+func available() bool {
+	initOnce.Do(func() {
+		src := C.CString(kernelSource)
+		defer C.free(unsafe.Pointer(src))
+		ready = C.keccakGPUInit(src, C.size_t(len(kernelSource))) == C.CL_SUCCESS
+	})
+	return ready
+}
+
+// hashBatch256 hashes msgs on the GPU into digests, and reports whether it
+// succeeded.
+func hashBatch256(msgs [][]byte, digests [][32]byte) bool {
+	n := 0
+	for _, m := range msgs {
+		n += len(m)
+	}
+	// A buffer cannot be empty, so data has a byte more than the messages.
+	data := make([]byte, 0, n+1)
+	off := make([]uint64, len(msgs)+1)
+	for i, m := range msgs {
+		data = append(data, m...)
+		off[i+1] = uint64(len(data))
+	}
+	data = append(data, 0)
+
+	mu.Lock()
+	defer mu.Unlock()
+	err := C.keccakGPUHash((*C.uchar)(&data[0]), C.size_t(len(data)),
+		(*C.cl_ulong)(&off[0]), C.size_t(len(msgs)), (*C.uchar)(&digests[0][0]))
+	return err == C.CL_SUCCESS
+}
@@ -0,0 +1,15 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !keccak_opencl || !cgo
+
+package gpu
+
+func available() bool {
+	return false
+}
+
+func hashBatch256(msgs [][]byte, digests [][32]byte) bool {
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gpu
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	keccak "github.com/filecoin-project/go-keccak"
+)
+
+// messages returns count messages of pseudorandom lengths and contents,
+// with lengths below max.
+func messages(count, max int) [][]byte {
+	r := rand.New(rand.NewPCG(1, 2))
+	msgs := make([][]byte, count)
+	for i := range msgs {
+		msgs[i] = make([]byte, r.IntN(max))
+		for j := range msgs[i] {
+			msgs[i][j] = byte(r.Uint32())
+		}
+	}
+	return msgs
+}
+
+// With a GPU, the batches are all hashed on it, including messages of one
+// or several blocks and of lengths around the rate.
+func TestHashBatch256(t *testing.T) {
+	defer func(old int) { minBatch = old }(minBatch)
+	minBatch = 1
+	t.Logf("GPU available: %v", Available())
+	for _, count := range []int{0, 1, 7, 1000} {
+		msgs := messages(count, 600)
+		if count == 7 {
+			for i, n := range []int{0, 135, 136, 137, 271, 272, 273} {
+				msgs[i] = msgs[i][:0]
+				for range n {
+					msgs[i] = append(msgs[i], byte(n))
+				}
+			}
+		}
+		got := HashBatch256(msgs)
+		if len(got) != count {
+			t.Fatalf("HashBatch256 of %d messages returned %d digests", count, len(got))
+		}
+		for i, m := range msgs {
+			if want := keccak.Sum256(m); got[i] != want {
+				t.Errorf("%d messages: digest %d = %x, want %x", count, i, got[i], want)
+			}
+		}
+	}
+}
+
+// BenchmarkHashBatch256 hashes a million messages of 32 bytes, on the GPU
+// if there is one, and with keccak.HashBatch256.
+func BenchmarkHashBatch256(b *testing.B) {
+	msgs := make([][]byte, 1<<20)
+	for i := range msgs {
+		msgs[i] = make([]byte, 32)
+		msgs[i][0], msgs[i][1], msgs[i][2] = byte(i), byte(i>>8), byte(i>>16)
+	}
+	for _, bc := range []struct {
+		name string
+		hash func([][]byte) [][32]byte
+	}{
+		{"GPU", HashBatch256},
+		{"CPU", keccak.HashBatch256},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			if bc.name == "GPU" && !Available() {
+				b.Skip("no GPU")
+			}
+			b.SetBytes(int64(32 * len(msgs)))
+			for i := 0; i < b.N; i++ {
+				bc.hash(msgs)
+			}
+		})
+	}
+}
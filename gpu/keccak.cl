@@ -0,0 +1,99 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The OpenCL kernel of the package: each work item computes the legacy
+// Keccak-256 digest of one message. The messages are concatenated in data,
+// message i running from off[i] to off[i+1], and the digests are written one
+// after the other to out. The lanes are assembled from bytes, so the result
+// does not depend on the byte order of the device.
+
+#define RATE 136
+#define ROL(x, n) (((x) << (n)) | ((x) >> (64 - (n))))
+
+__constant ulong rc[24] = {
+	0x0000000000000001UL, 0x0000000000008082UL, 0x800000000000808aUL,
+	0x8000000080008000UL, 0x000000000000808bUL, 0x0000000080000001UL,
+	0x8000000080008081UL, 0x8000000000008009UL, 0x000000000000008aUL,
+	0x0000000000000088UL, 0x0000000080008009UL, 0x000000008000000aUL,
+	0x000000008000808bUL, 0x800000000000008bUL, 0x8000000000008089UL,
+	0x8000000000008003UL, 0x8000000000008002UL, 0x8000000000000080UL,
+	0x000000000000800aUL, 0x800000008000000aUL, 0x8000000080008081UL,
+	0x8000000000008080UL, 0x0000000080000001UL, 0x8000000080008008UL,
+};
+
+// rho[i] is the rotation of lane i, and pi[i] the lane it moves to.
+__constant int rho[25] = {
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+};
+
+__constant int pi[25] = {
+	0, 10, 20, 5, 15,
+	16, 1, 11, 21, 6,
+	7, 17, 2, 12, 22,
+	23, 8, 18, 3, 13,
+	14, 24, 9, 19, 4,
+};
+
+void keccakf(ulong *a) {
+	ulong b[25], c[5], d;
+	for (int r = 0; r < 24; r++) {
+		for (int x = 0; x < 5; x++) {
+			c[x] = a[x] ^ a[x + 5] ^ a[x + 10] ^ a[x + 15] ^ a[x + 20];
+		}
+		for (int x = 0; x < 5; x++) {
+			d = c[(x + 4) % 5] ^ ROL(c[(x + 1) % 5], 1);
+			for (int y = 0; y < 25; y += 5) {
+				a[x + y] ^= d;
+			}
+		}
+		b[0] = a[0];
+		for (int i = 1; i < 25; i++) {
+			b[pi[i]] = ROL(a[i], rho[i]);
+		}
+		for (int y = 0; y < 25; y += 5) {
+			for (int x = 0; x < 5; x++) {
+				a[x + y] = b[x + y] ^ (~b[(x + 1) % 5 + y] & b[(x + 2) % 5 + y]);
+			}
+		}
+		a[0] ^= rc[r];
+	}
+}
+
+__kernel void keccak256(__global const uchar *data, __global const ulong *off,
+                        __global uchar *out) {
+	size_t i = get_global_id(0);
+	ulong p = off[i], end = off[i + 1];
+	ulong a[25];
+	uchar block[RATE];
+
+	for (int j = 0; j < 25; j++) {
+		a[j] = 0;
+	}
+	for (; end - p >= RATE; p += RATE) {
+		for (int j = 0; j < RATE; j++) {
+			a[j / 8] ^= (ulong)data[p + j] << (8 * (j % 8));
+		}
+		keccakf(a);
+	}
+
+	// The last block, padded with the domain separation byte of Keccak.
+	int n = (int)(end - p);
+	for (int j = 0; j < RATE; j++) {
+		block[j] = j < n ? data[p + j] : 0;
+	}
+	block[n] ^= 0x01;
+	block[RATE - 1] ^= 0x80;
+	for (int j = 0; j < RATE; j++) {
+		a[j / 8] ^= (ulong)block[j] << (8 * (j % 8));
+	}
+	keccakf(a);
+
+	for (int j = 0; j < 32; j++) {
+		out[32 * i + j] = (uchar)(a[j / 8] >> (8 * (j % 8)));
+	}
+}
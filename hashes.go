@@ -6,12 +6,56 @@ package keccak
 
 import "hash"
 
+// New224 creates a new SHA3-224 hash.
+// Its generic security strength is 224 bits against preimage attacks,
+// and 112 bits against collision attacks.
+func New224() hash.Hash {
+	return newState(state{rate: rateK448, outputLen: 28, dsbyte: dsbyteSHA3})
+}
+
+// New256 creates a new SHA3-256 hash.
+// Its generic security strength is 256 bits against preimage attacks,
+// and 128 bits against collision attacks.
+func New256() hash.Hash {
+	return newState(state{rate: rateK512, outputLen: 32, dsbyte: dsbyteSHA3})
+}
+
+// New384 creates a new SHA3-384 hash.
+// Its generic security strength is 384 bits against preimage attacks,
+// and 192 bits against collision attacks.
+func New384() hash.Hash {
+	return newState(state{rate: rateK768, outputLen: 48, dsbyte: dsbyteSHA3})
+}
+
+// New512 creates a new SHA3-512 hash.
+// Its generic security strength is 512 bits against preimage attacks,
+// and 256 bits against collision attacks.
+func New512() hash.Hash {
+	return newState(state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteSHA3})
+}
+
+// NewLegacyKeccak224 creates a new Keccak-224 hash.
+//
+// Only use this function if you require compatibility with an existing cryptosystem
+// that uses non-standard padding. All other users should use [crypto/sha3.New224] instead.
+func NewLegacyKeccak224() hash.Hash {
+	return newState(state{rate: rateK448, outputLen: 28, dsbyte: dsbyteKeccak})
+}
+
 // NewLegacyKeccak256 creates a new Keccak-256 hash.
 //
 // Only use this function if you require compatibility with an existing cryptosystem
 // that uses non-standard padding. All other users should use [crypto/sha3.New256] instead.
 func NewLegacyKeccak256() hash.Hash {
-	return &state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	return newState(state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak})
+}
+
+// NewLegacyKeccak384 creates a new Keccak-384 hash.
+//
+// Only use this function if you require compatibility with an existing cryptosystem
+// that uses non-standard padding. All other users should use [crypto/sha3.New384] instead.
+func NewLegacyKeccak384() hash.Hash {
+	return newState(state{rate: rateK768, outputLen: 48, dsbyte: dsbyteKeccak})
 }
 
 // NewLegacyKeccak512 creates a new Keccak-512 hash.
@@ -19,5 +63,71 @@ func NewLegacyKeccak256() hash.Hash {
 // Only use this function if you require compatibility with an existing cryptosystem
 // that uses non-standard padding. All other users should use [crypto/sha3.New512] instead.
 func NewLegacyKeccak512() hash.Hash {
-	return &state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteKeccak}
+	return newState(state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteKeccak})
+}
+
+// NewLegacyKeccakXOF256 creates a new ShakeHash with the legacy Keccak
+// padding and the rate of Keccak-256, from which output of any length can
+// be read. This is Keccak[c=512] of the original Keccak proposal: its
+// first 32 bytes of output are the Keccak-256 digest, which Sum returns.
+func NewLegacyKeccakXOF256() ShakeHash {
+	return newState(state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak})
+}
+
+// NewLegacyKeccakXOF512 creates a new ShakeHash with the legacy Keccak
+// padding and the rate of Keccak-512, from which output of any length can
+// be read. This is Keccak[c=1024] of the original Keccak proposal: its
+// first 64 bytes of output are the Keccak-512 digest, which Sum returns.
+func NewLegacyKeccakXOF512() ShakeHash {
+	return newState(state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteKeccak})
+}
+
+// Sum256 returns the legacy Keccak-256 digest of the data.
+func Sum256(data []byte) (digest [32]byte) {
+	switch {
+	case len(data) == 32:
+		return Keccak256Of32([32]byte(data))
+	case len(data) == 64:
+		return Keccak256Of64([64]byte(data))
+	case len(data) < rateK512:
+		sumBlock(digest[:], data, rateK512)
+		return
+	}
+	d := state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	d.Write(data)
+	d.Read(digest[:])
+	return
+}
+
+// AppendSum256 appends the legacy Keccak-256 digest of the data to dst and
+// returns the resulting slice. It does not allocate if dst has room for the
+// 32 bytes of the digest.
+func AppendSum256(dst, data []byte) []byte {
+	digest := Sum256(data)
+	return append(dst, digest[:]...)
+}
+
+// Sum512 returns the legacy Keccak-512 digest of the data.
+func Sum512(data []byte) (digest [64]byte) {
+	if len(data) < rateK1024 {
+		sumBlock(digest[:], data, rateK1024)
+		return
+	}
+	d := state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteKeccak}
+	d.Write(data)
+	d.Read(digest[:])
+	return
+}
+
+// sumBlock writes to out the legacy Keccak digest of data, which is shorter
+// than rate and so fits with its padding in a single block. The block is
+// copied into the zero state and permuted once, skipping the buffering of
+// Write and the squeezing loop of Read.
+func sumBlock(out, data []byte, rate int) {
+	d := state{rate: rate, dsbyte: dsbyteKeccak}
+	copy(d.a[:], data)
+	d.n = len(data)
+	d.counts.absorbedBytes(len(data))
+	d.padAndPermute()
+	d.counts.squeezedBytes(copy(out, d.a[:]))
 }
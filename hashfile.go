@@ -0,0 +1,34 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "os"
+
+// HashFile returns the legacy Keccak-256 digest of the contents of the named
+// file. On Unix systems, large regular files are memory-mapped and absorbed
+// straight from the mapping, which saves the read system calls and the copy
+// into a buffer, with hints to the kernel to read ahead of the hashing.
+// Other files are read into a pooled buffer.
+//
+// The file must not be truncated while it is hashed. If it is, HashFile
+// returns an error rather than crashing the program.
+func HashFile(name string) ([32]byte, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	defer f.Close()
+	return hashFile(f)
+}
+
+// hashFileRead hashes the rest of f by reading it.
+func hashFileRead(f *os.File) (digest [32]byte, err error) {
+	d := state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	if _, err := d.ReadFrom(f); err != nil {
+		return digest, err
+	}
+	d.Read(digest[:])
+	return digest, nil
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "syscall"
+
+// adviseSequential tells the kernel that the mapping m will be read in
+// order, so that it reads ahead aggressively and drops pages once read.
+func adviseSequential(m []byte) {
+	syscall.Madvise(m, syscall.MADV_SEQUENTIAL)
+}
+
+// adviseWillNeed tells the kernel that the mapping m will be read soon, so
+// that it starts reading it in.
+func adviseWillNeed(m []byte) {
+	if len(m) > 0 {
+		syscall.Madvise(m, syscall.MADV_WILLNEED)
+	}
+}
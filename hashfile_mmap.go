@@ -0,0 +1,66 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package keccak
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"syscall"
+)
+
+const (
+	// mmapMinSize is the size from which HashFile maps a file rather than
+	// reading it. Below it, setting up and tearing down the mapping costs
+	// more than the copies it saves.
+	mmapMinSize = 1 << 20
+
+	// mmapWindow is the size of the slices of a mapped file absorbed at
+	// once, while the kernel is asked to read the next one ahead. It is a
+	// multiple of the rate, so that every slice is absorbed as whole
+	// blocks straight from the mapping.
+	mmapWindow = rateK512 << 15
+)
+
+func hashFile(f *os.File) (digest [32]byte, err error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return digest, err
+	}
+	size := fi.Size()
+	if !fi.Mode().IsRegular() || size < mmapMinSize || int64(int(size)) != size {
+		return hashFileRead(f)
+	}
+	m, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return hashFileRead(f)
+	}
+	defer syscall.Munmap(m)
+
+	// Reading past the end of a file truncated since it was mapped faults.
+	// The fault is turned into a panic, and the panic into an error.
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(interface{ Addr() uintptr }); !ok {
+				panic(r)
+			}
+			digest, err = [32]byte{}, fmt.Errorf("keccak: fault reading %s, which may have been truncated", f.Name())
+		}
+	}()
+
+	adviseSequential(m)
+	d := state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak}
+	for len(m) > 0 {
+		n := min(len(m), mmapWindow)
+		adviseWillNeed(m[n:min(len(m), 2*mmapWindow)])
+		d.Write(m[:n])
+		m = m[n:]
+	}
+	d.Read(digest[:])
+	return digest, nil
+}
@@ -0,0 +1,13 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix
+
+package keccak
+
+import "os"
+
+func hashFile(f *os.File) ([32]byte, error) {
+	return hashFileRead(f)
+}
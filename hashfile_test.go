@@ -0,0 +1,52 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHashFile(t *testing.T) {
+	dir := t.TempDir()
+	// The sizes straddle the size from which files are mapped, and the
+	// windows in which mapped files are absorbed.
+	for _, size := range []int{0, 100, 1<<20 - 1, 1 << 20, 1<<20 + 3*rateK512 + 5, 2*(rateK512<<15) + 100} {
+		data := ptn(size)
+		name := filepath.Join(dir, "file")
+		if err := os.WriteFile(name, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := HashFile(name)
+		if err != nil {
+			t.Fatalf("HashFile(%d bytes): %v", size, err)
+		}
+		if want := Sum256(data); got != want {
+			t.Errorf("HashFile(%d bytes) = %x, want %x", size, got, want)
+		}
+	}
+
+	if _, err := HashFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("HashFile of a missing file succeeded")
+	}
+	if _, err := HashFile(dir); err == nil {
+		t.Error("HashFile of a directory succeeded")
+	}
+}
+
+func BenchmarkHashFile(b *testing.B) {
+	const size = 16 << 20
+	name := filepath.Join(b.TempDir(), "file")
+	if err := os.WriteFile(name, make([]byte, size), 0o600); err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(size)
+	for i := 0; i < b.N; i++ {
+		if _, err := HashFile(name); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
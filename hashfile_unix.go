@@ -0,0 +1,14 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix && !linux
+
+package keccak
+
+// The syscall package only has madvise on Linux; elsewhere the kernel's
+// own readahead has to do.
+
+func adviseSequential(m []byte) {}
+
+func adviseWillNeed(m []byte) {}
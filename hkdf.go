@@ -0,0 +1,101 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides HKDF, the HMAC-based key derivation function of
+// RFC 5869 [1], for use with the legacy Keccak hashes of this package. It
+// is adapted from golang.org/x/crypto/hkdf.
+//
+// [1] https://www.rfc-editor.org/rfc/rfc5869
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+	"io"
+)
+
+// HKDFExtract returns a pseudorandom key for use with HKDFExpand, derived
+// from the input keying material secret and the optional salt. An empty
+// salt is replaced by a string of zeros of the hash output size.
+func HKDFExtract(h func() hash.Hash, secret, salt []byte) []byte {
+	if len(salt) == 0 {
+		salt = make([]byte, h().Size())
+	}
+	extractor := hmac.New(h, salt)
+	extractor.Write(secret)
+	return extractor.Sum(nil)
+}
+
+type hkdf struct {
+	expander hash.Hash
+	size     int
+
+	info    []byte
+	counter byte
+
+	prev []byte
+	buf  []byte
+}
+
+func (f *hkdf) Read(p []byte) (int, error) {
+	// Check whether enough data can be generated.
+	need := len(p)
+	remains := len(f.buf) + int(255-f.counter+1)*f.size
+	if remains < need {
+		return 0, errors.New("keccak: HKDF entropy limit reached")
+	}
+	// Read any leftover from the buffer.
+	n := copy(p, f.buf)
+	p = p[n:]
+
+	// Fill the rest of the buffer.
+	for len(p) > 0 {
+		if f.counter > 1 {
+			f.expander.Reset()
+		}
+		f.expander.Write(f.prev)
+		f.expander.Write(f.info)
+		f.expander.Write([]byte{f.counter})
+		f.prev = f.expander.Sum(f.prev[:0])
+		f.counter++
+
+		// Copy the new batch into p.
+		f.buf = f.prev
+		n = copy(p, f.buf)
+		p = p[n:]
+	}
+	// Save leftovers for next run.
+	f.buf = f.buf[n:]
+
+	return need, nil
+}
+
+// HKDFExpand returns a Reader from which keys can be read, using the given
+// pseudorandom key and optional info. At most 255 times the hash output
+// size bytes can be read; beyond that, Read returns an error.
+func HKDFExpand(h func() hash.Hash, pseudorandomKey, info []byte) io.Reader {
+	expander := hmac.New(h, pseudorandomKey)
+	return &hkdf{expander, expander.Size(), info, 1, nil, nil}
+}
+
+// NewHKDF returns a Reader from which keys can be read, using the given
+// hash, secret, salt and info, as HKDFExpand(h, HKDFExtract(h, secret,
+// salt), info).
+func NewHKDF(h func() hash.Hash, secret, salt, info []byte) io.Reader {
+	prk := HKDFExtract(h, secret, salt)
+	return HKDFExpand(h, prk, info)
+}
+
+// HKDFDerive returns a key of the given length derived from secret, salt
+// and info in one call. It returns an error if length exceeds 255 times
+// the hash output size.
+func HKDFDerive(h func() hash.Hash, secret, salt, info []byte, length int) ([]byte, error) {
+	key := make([]byte, length)
+	if _, err := io.ReadFull(NewHKDF(h, secret, salt, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
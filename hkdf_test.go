@@ -0,0 +1,72 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	stdhkdf "crypto/hkdf"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"testing"
+)
+
+func TestHKDFRFC5869(t *testing.T) {
+	// RFC 5869, Appendix A.1, with SHA-256.
+	ikm := bytes.Repeat([]byte{0x0b}, 22)
+	salt, _ := hex.DecodeString("000102030405060708090a0b0c")
+	info, _ := hex.DecodeString("f0f1f2f3f4f5f6f7f8f9")
+
+	prk := HKDFExtract(sha256.New, ikm, salt)
+	if got, want := hex.EncodeToString(prk), "077709362c2e32df0ddc3f0dc47bba6390b6c73bb50f9c3122ec844ad7c2b3e5"; got != want {
+		t.Errorf("PRK = %s, want %s", got, want)
+	}
+	okm, err := HKDFDerive(sha256.New, ikm, salt, info, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hex.EncodeToString(okm), "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865"; got != want {
+		t.Errorf("OKM = %s, want %s", got, want)
+	}
+}
+
+func TestHKDFKeccak(t *testing.T) {
+	// HMAC must use the rate of the legacy Keccak hashes as block size;
+	// the standard library HKDF does the same through hash.Hash.
+	for _, h := range []func() hash.Hash{NewLegacyKeccak256, NewLegacyKeccak512} {
+		secret, salt, info := ptn(50), []byte("salt"), []byte("info")
+		for _, length := range []int{1, 32, 100, 255 * h().Size()} {
+			want, err := stdhkdf.Key(h, secret, salt, string(info), length)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := HKDFDerive(h, secret, salt, info, length)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("size %d, length %d: output differs from crypto/hkdf", h().Size(), length)
+			}
+		}
+	}
+}
+
+func TestHKDFLimit(t *testing.T) {
+	r := NewHKDF(NewLegacyKeccak256, []byte("secret"), nil, nil)
+	buf := make([]byte, 255*32)
+	if _, err := io.ReadFull(r, buf[:100]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(r, buf[100:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(buf[:1]); err == nil {
+		t.Error("Read beyond the limit succeeded")
+	}
+	if _, err := HKDFDerive(NewLegacyKeccak256, nil, nil, nil, 255*32+1); err == nil {
+		t.Error("HKDFDerive accepted a length beyond the limit")
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package xkcp runs Keccak-f[1600] with XKCP, the Keccak team's C library
+// (https://github.com/XKCP/XKCP), and exposes the constructions of XKCP
+// that the tests of the keccak package check their own against. It is only
+// built with the keccak_xkcp build tag, and it needs libXKCP.a built for
+// the target, for example with
+//
+//	make generic64/libXKCP.a
+//
+// in a checkout of XKCP, and its headers and library in the search paths
+// of the C compiler, such as with
+//
+//	CGO_CFLAGS=-I$XKCP/bin/generic64/libXKCP.a.headers
+//	CGO_LDFLAGS=-L$XKCP/bin/generic64
+//
+// It is a package of its own because a package using cgo cannot contain Go
+// assembly.
+package xkcp
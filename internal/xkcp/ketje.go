@@ -0,0 +1,69 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package xkcp
+
+/*
+#include <Ketjev2.h>
+
+// ketjeSealWith defines a function that wraps the plaintext and appends
+// the tag with one instance of Ketje, and returns 0 on success.
+#define ketjeSealWith(name) \
+static int name##Seal(const unsigned char *key, unsigned int keyLen, const unsigned char *nonce, unsigned int nonceLen, const unsigned char *ad, unsigned int adLen, const unsigned char *pt, unsigned int ptLen, unsigned char *ct) { \
+	name##_Instance inst; \
+	if (name##_Initialize(&inst, key, 8 * keyLen, nonce, 8 * nonceLen) != 0 || \
+	    name##_FeedAssociatedData(&inst, ad, adLen) != 0 || \
+	    name##_WrapPlaintext(&inst, pt, ct, ptLen) != 0 || \
+	    name##_GetTag(&inst, ct + ptLen, 16) != 0) { \
+		return -1; \
+	} \
+	return 0; \
+}
+
+ketjeSealWith(KetjeJr)
+ketjeSealWith(KetjeSr)
+ketjeSealWith(KetjeMinor)
+ketjeSealWith(KetjeMajor)
+
+static int ketjeSeal(int instance, const unsigned char *key, unsigned int keyLen, const unsigned char *nonce, unsigned int nonceLen, const unsigned char *ad, unsigned int adLen, const unsigned char *pt, unsigned int ptLen, unsigned char *ct) {
+	switch (instance) {
+	case 0:
+		return KetjeJrSeal(key, keyLen, nonce, nonceLen, ad, adLen, pt, ptLen, ct);
+	case 1:
+		return KetjeSrSeal(key, keyLen, nonce, nonceLen, ad, adLen, pt, ptLen, ct);
+	case 2:
+		return KetjeMinorSeal(key, keyLen, nonce, nonceLen, ad, adLen, pt, ptLen, ct);
+	case 3:
+		return KetjeMajorSeal(key, keyLen, nonce, nonceLen, ad, adLen, pt, ptLen, ct);
+	}
+	return -1;
+}
+*/
+import "C"
+
+// A Ketje is an instance of Ketje v2.
+type Ketje int
+
+// The instances of Ketje, in the order of the dispatch in ketjeSeal.
+const (
+	KetjeJr Ketje = iota
+	KetjeSr
+	KetjeMinor
+	KetjeMajor
+)
+
+// Seal returns the ciphertext of plaintext followed by a 16-byte tag, as
+// XKCP computes them for the given key, nonce and associated data. It
+// panics if XKCP rejects the arguments.
+func (k Ketje) Seal(key, nonce, plaintext, additionalData []byte) []byte {
+	out := make([]byte, len(plaintext)+16)
+	if C.ketjeSeal(C.int(k), ptr(key), C.uint(len(key)), ptr(nonce), C.uint(len(nonce)),
+		ptr(additionalData), C.uint(len(additionalData)),
+		ptr(plaintext), C.uint(len(plaintext)), ptr(out)) != 0 {
+		panic("xkcp: Ketje failed")
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package xkcp
+
+/*
+#include <stdlib.h>
+#include <Keyakv2.h>
+
+// keyakStart allocates an instance of River Keyak, or of Lake Keyak if
+// lake is set, and starts a session without a tag. It returns NULL on
+// failure.
+static void *keyakStart(int lake, const unsigned char *key, unsigned int keyLen, const unsigned char *nonce, unsigned int nonceLen) {
+	void *inst;
+	int ok;
+	if (posix_memalign(&inst, 64, lake ? sizeof(LakeKeyak_Instance) : sizeof(RiverKeyak_Instance)) != 0) {
+		return NULL;
+	}
+	if (lake) {
+		ok = LakeKeyak_Initialize(inst, key, keyLen, nonce, nonceLen, 0, NULL, 0, 0);
+	} else {
+		ok = RiverKeyak_Initialize(inst, key, keyLen, nonce, nonceLen, 0, NULL, 0, 0);
+	}
+	if (ok != 1) {
+		free(inst);
+		return NULL;
+	}
+	return inst;
+}
+
+// keyakWrap wraps the next message of the session and writes its tag, and
+// returns 1 on success.
+static int keyakWrap(int lake, void *inst, const unsigned char *in, unsigned char *out, size_t len, const unsigned char *ad, size_t adLen, unsigned char *tag) {
+	if (lake) {
+		return LakeKeyak_Wrap(inst, in, out, len, ad, adLen, tag, 0, 0);
+	}
+	return RiverKeyak_Wrap(inst, in, out, len, ad, adLen, tag, 0, 0);
+}
+*/
+import "C"
+
+import "unsafe"
+
+// A Keyak is an instance of Keyak v2.
+type Keyak int
+
+const (
+	RiverKeyak Keyak = iota
+	LakeKeyak
+)
+
+// A KeyakSession is a Keyak session of XKCP. It must be closed.
+type KeyakSession struct {
+	lake C.int
+	inst unsafe.Pointer
+}
+
+// NewSession starts a session with the given key and nonce. It panics if
+// XKCP rejects them.
+func (k Keyak) NewSession(key, nonce []byte) *KeyakSession {
+	s := &KeyakSession{}
+	if k == LakeKeyak {
+		s.lake = 1
+	}
+	s.inst = C.keyakStart(s.lake, ptr(key), C.uint(len(key)), ptr(nonce), C.uint(len(nonce)))
+	if s.inst == nil {
+		panic("xkcp: Keyak failed")
+	}
+	return s
+}
+
+// Seal returns the ciphertext of the next message of the session followed
+// by its 16-byte tag.
+func (s *KeyakSession) Seal(plaintext, additionalData []byte) []byte {
+	out := make([]byte, len(plaintext)+16)
+	if C.keyakWrap(s.lake, s.inst, ptr(plaintext), ptr(out), C.size_t(len(plaintext)),
+		ptr(additionalData), C.size_t(len(additionalData)), ptr(out[len(plaintext):])) != 1 {
+		panic("xkcp: Keyak failed")
+	}
+	return out
+}
+
+// Close frees the session.
+func (s *KeyakSession) Close() {
+	C.free(s.inst)
+	s.inst = nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package xkcp
+
+/*
+#include <stdlib.h>
+#include <Kravatte.h>
+#include <KravatteModes.h>
+
+// The instances are allocated in C, aligned as XKCP declares them.
+
+static void *kravatteAlloc(size_t size) {
+	void *inst;
+	if (posix_memalign(&inst, 64, size) != 0) {
+		return NULL;
+	}
+	return inst;
+}
+
+static void *kravatteNew(const unsigned char *key, size_t keyLen) {
+	void *inst = kravatteAlloc(sizeof(Kravatte_Instance));
+	if (inst != NULL && Kravatte_MaskDerivation(inst, key, 8 * keyLen) != 0) {
+		free(inst);
+		return NULL;
+	}
+	return inst;
+}
+
+static int kravatteCompress(void *inst, const unsigned char *m, size_t bitLen, int first) {
+	return Kra(inst, m, bitLen, first ? KRAVATTE_FLAG_INIT | KRAVATTE_FLAG_LAST : KRAVATTE_FLAG_LAST);
+}
+
+static int kravatteExpand(void *inst, unsigned char *out, size_t len) {
+	return Vatte(inst, out, 8 * len, KRAVATTE_FLAG_LAST);
+}
+
+static void *kravatteSANENew(const unsigned char *key, size_t keyLen, const unsigned char *nonce, size_t nonceLen, unsigned char *tag) {
+	void *inst = kravatteAlloc(sizeof(Kravatte_SANE_Instance));
+	if (inst != NULL && Kravatte_SANE_Initialize(inst, key, 8 * keyLen, nonce, 8 * nonceLen, tag) != 0) {
+		free(inst);
+		return NULL;
+	}
+	return inst;
+}
+
+static int kravatteSANEWrap(void *inst, const unsigned char *pt, unsigned char *ct, size_t len, const unsigned char *ad, size_t adLen, unsigned char *tag) {
+	return Kravatte_SANE_Wrap(inst, pt, ct, 8 * len, ad, 8 * adLen, tag);
+}
+
+static void *kravatteSANSENew(const unsigned char *key, size_t keyLen) {
+	void *inst = kravatteAlloc(sizeof(Kravatte_SANSE_Instance));
+	if (inst != NULL && Kravatte_SANSE_Initialize(inst, key, 8 * keyLen) != 0) {
+		free(inst);
+		return NULL;
+	}
+	return inst;
+}
+
+static int kravatteSANSEWrap(void *inst, const unsigned char *pt, unsigned char *ct, size_t len, const unsigned char *ad, size_t adLen, unsigned char *tag) {
+	return Kravatte_SANSE_Wrap(inst, pt, ct, 8 * len, ad, 8 * adLen, tag);
+}
+
+static void *kravatteWBCNew(const unsigned char *key, size_t keyLen) {
+	void *inst = kravatteAlloc(sizeof(Kravatte_Instance));
+	if (inst != NULL && Kravatte_WBC_Initialize(inst, key, 8 * keyLen) != 0) {
+		free(inst);
+		return NULL;
+	}
+	return inst;
+}
+
+static int kravatteWBCEncipher(void *inst, const unsigned char *pt, unsigned char *ct, size_t len, const unsigned char *w, size_t wLen) {
+	return Kravatte_WBC_Encipher(inst, pt, ct, 8 * len, w, 8 * wLen);
+}
+*/
+import "C"
+
+import "unsafe"
+
+// Kravatte is the Kravatte deck function of XKCP. It must be closed.
+type Kravatte struct {
+	inst unsafe.Pointer
+}
+
+// NewKravatte returns the deck function for the given key. It panics if
+// XKCP rejects the key.
+func NewKravatte(key []byte) *Kravatte {
+	inst := C.kravatteNew(ptr(key), C.size_t(len(key)))
+	if inst == nil {
+		panic("xkcp: Kravatte failed")
+	}
+	return &Kravatte{inst}
+}
+
+// Compress compresses the next string of the input sequence, which is m
+// followed by the suffixBits low bits of suffix. The first string of a
+// sequence starts a new one.
+func (k *Kravatte) Compress(m []byte, suffix byte, suffixBits int, first bool) {
+	b := append(append([]byte(nil), m...), suffix)
+	var f C.int
+	if first {
+		f = 1
+	}
+	if C.kravatteCompress(k.inst, ptr(b), C.size_t(8*len(m)+suffixBits), f) != 0 {
+		panic("xkcp: Kravatte failed")
+	}
+}
+
+// Expand returns the first n bytes of the output for the sequence
+// compressed so far, and ends the sequence.
+func (k *Kravatte) Expand(n int) []byte {
+	out := make([]byte, n)
+	if C.kravatteExpand(k.inst, ptr(out), C.size_t(n)) != 0 {
+		panic("xkcp: Kravatte failed")
+	}
+	return out
+}
+
+// Close frees the deck function.
+func (k *Kravatte) Close() {
+	C.free(k.inst)
+	k.inst = nil
+}
+
+// A KravatteSANE is a Kravatte-SANE session of XKCP. It must be closed.
+type KravatteSANE struct {
+	inst unsafe.Pointer
+}
+
+// NewKravatteSANE starts a session with the given key and nonce, and
+// returns it with the 16-byte tag of the nonce. It panics if XKCP rejects
+// the key.
+func NewKravatteSANE(key, nonce []byte) (*KravatteSANE, []byte) {
+	tag := make([]byte, 16)
+	inst := C.kravatteSANENew(ptr(key), C.size_t(len(key)), ptr(nonce), C.size_t(len(nonce)), ptr(tag))
+	if inst == nil {
+		panic("xkcp: Kravatte-SANE failed")
+	}
+	return &KravatteSANE{inst}, tag
+}
+
+// Seal returns the ciphertext of the next message of the session followed
+// by its 16-byte tag.
+func (s *KravatteSANE) Seal(plaintext, additionalData []byte) []byte {
+	out := make([]byte, len(plaintext)+16)
+	if C.kravatteSANEWrap(s.inst, ptr(plaintext), ptr(out), C.size_t(len(plaintext)),
+		ptr(additionalData), C.size_t(len(additionalData)), ptr(out[len(plaintext):])) != 0 {
+		panic("xkcp: Kravatte-SANE failed")
+	}
+	return out
+}
+
+// Close frees the session.
+func (s *KravatteSANE) Close() {
+	C.free(s.inst)
+	s.inst = nil
+}
+
+// A KravatteSANSE is a Kravatte-SANSE session of XKCP. It must be closed.
+type KravatteSANSE struct {
+	inst unsafe.Pointer
+}
+
+// NewKravatteSANSE starts a session with the given key. It panics if XKCP
+// rejects the key.
+func NewKravatteSANSE(key []byte) *KravatteSANSE {
+	inst := C.kravatteSANSENew(ptr(key), C.size_t(len(key)))
+	if inst == nil {
+		panic("xkcp: Kravatte-SANSE failed")
+	}
+	return &KravatteSANSE{inst}
+}
+
+// Seal returns the ciphertext of the next message of the session followed
+// by its 16-byte tag.
+func (s *KravatteSANSE) Seal(plaintext, additionalData []byte) []byte {
+	out := make([]byte, len(plaintext)+16)
+	if C.kravatteSANSEWrap(s.inst, ptr(plaintext), ptr(out), C.size_t(len(plaintext)),
+		ptr(additionalData), C.size_t(len(additionalData)), ptr(out[len(plaintext):])) != 0 {
+		panic("xkcp: Kravatte-SANSE failed")
+	}
+	return out
+}
+
+// Close frees the session.
+func (s *KravatteSANSE) Close() {
+	C.free(s.inst)
+	s.inst = nil
+}
+
+// A KravatteWBC is the Kravatte-WBC cipher of XKCP. It must be closed.
+type KravatteWBC struct {
+	inst unsafe.Pointer
+}
+
+// NewKravatteWBC returns the cipher for the given key. It panics if XKCP
+// rejects the key.
+func NewKravatteWBC(key []byte) *KravatteWBC {
+	inst := C.kravatteWBCNew(ptr(key), C.size_t(len(key)))
+	if inst == nil {
+		panic("xkcp: Kravatte-WBC failed")
+	}
+	return &KravatteWBC{inst}
+}
+
+// Encrypt returns the encryption of src with the given tweak.
+func (c *KravatteWBC) Encrypt(src, tweak []byte) []byte {
+	out := make([]byte, len(src))
+	if C.kravatteWBCEncipher(c.inst, ptr(src), ptr(out), C.size_t(len(src)), ptr(tweak), C.size_t(len(tweak))) != 0 {
+		panic("xkcp: Kravatte-WBC failed")
+	}
+	return out
+}
+
+// Close frees the cipher.
+func (c *KravatteWBC) Close() {
+	C.free(c.inst)
+	c.inst = nil
+}
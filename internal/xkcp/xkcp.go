@@ -0,0 +1,52 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package xkcp
+
+/*
+#cgo LDFLAGS: -lXKCP
+#cgo noescape keccakF1600XKCP
+#cgo nocallback keccakF1600XKCP
+#include <KeccakP-1600-SnP.h>
+
+// These wrap the SnP interface, some of whose functions are macros on
+// some targets. The state is loaded and stored with the byte-oriented
+// functions, so that whichever layout the target keeps it in, such as
+// complemented lanes, the caller sees lanes in the usual order.
+
+static void keccakXKCPInit(void) {
+	KeccakP1600_StaticInitialize();
+}
+
+static void keccakF1600XKCP(unsigned char *a) {
+	_Alignas(64) unsigned char s[KeccakP1600_stateSizeInBytes];
+	KeccakP1600_Initialize((void *)s);
+	KeccakP1600_AddBytes((void *)s, a, 0, 200);
+	KeccakP1600_Permute_24rounds((void *)s);
+	KeccakP1600_ExtractBytes((void *)s, a, 0, 200);
+}
+*/
+import "C"
+
+import "unsafe"
+
+func init() {
+	C.keccakXKCPInit()
+}
+
+// Permute applies Keccak-f[1600] to the state a, whose lanes are stored in
+// little-endian order.
+func Permute(a *[200]byte) {
+	C.keccakF1600XKCP((*C.uchar)(unsafe.Pointer(a)))
+}
+
+// ptr returns a pointer to the first byte of b, or nil if b is empty.
+func ptr(b []byte) *C.uchar {
+	if len(b) == 0 {
+		return nil
+	}
+	return (*C.uchar)(unsafe.Pointer(&b[0]))
+}
@@ -0,0 +1,202 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the KangarooTwelve tree hash, as specified in
+// RFC 9861 [1], where it is also called KT128, and its sibling KT256 with
+// a 512-bit capacity. MarsupilamiFourteen, described alongside
+// KangarooTwelve in [2], uses the same tree with 14 rounds and a 512-bit
+// capacity.
+//
+// [1] https://www.rfc-editor.org/rfc/rfc9861
+// [2] https://eprint.iacr.org/2016/770
+
+const (
+	// k12ChunkSize is the size of the first chunk, which is absorbed by
+	// the final node directly, and of every leaf after it.
+	k12ChunkSize = 8192
+
+	// k12Rounds is the number of rounds of Keccak-p[1600] used by
+	// TurboSHAKE, and thus by KangarooTwelve.
+	k12Rounds = 12
+
+	// m14Rounds is the number of rounds of Keccak-p[1600] used by
+	// MarsupilamiFourteen.
+	m14Rounds = 14
+)
+
+// kangarooTwelve is a Sakura tree with kangaroo hopping, as used by
+// KangarooTwelve. The message, followed by the customization suffix, is cut
+// into chunks of chunkSize bytes. The first chunk is absorbed by the final
+// node directly; if there are more, each is hashed as a separate leaf whose
+// chaining value is then absorbed by the final node.
+type kangarooTwelve struct {
+	final *state // the final node; its dsbyte is chosen on finalization
+
+	coding    sakuraCoding // the domain separation bytes of the nodes
+	chunkSize int          // the size of the first chunk and of each leaf
+
+	// custom is C || length_encode(|C|), appended to the message on
+	// finalization. It is empty for trees without customization.
+	custom []byte
+
+	first  int        // the length of the first chunk absorbed so far
+	tree   bool       // whether input has spilled past the first chunk
+	leaves treeLeaves // the leaves after the first chunk
+}
+
+func newKangarooTwelve(custom []byte, rate, cvLen, outputLen, rounds int) *kangarooTwelve {
+	k := newSakuraTree(sakuraTurboShake, k12ChunkSize, rate, cvLen, outputLen, rounds)
+	k.custom = make([]byte, 0, len(custom)+9)
+	k.custom = append(k.custom, custom...)
+	k.custom = append(k.custom, lengthEncode(uint64(len(custom)))...)
+	return k
+}
+
+// newSakuraTree returns a tree without customization whose nodes are coded
+// with coding and hashed with the sponge given by rate and rounds.
+func newSakuraTree(coding sakuraCoding, chunkSize, rate, cvLen, outputLen, rounds int) *kangarooTwelve {
+	return &kangarooTwelve{
+		final:     newState(state{rate: rate, outputLen: outputLen, rounds: rounds}),
+		coding:    coding,
+		chunkSize: chunkSize,
+		leaves: treeLeaves{
+			leaf:  state{rate: rate, dsbyte: coding.leaf, rounds: rounds},
+			size:  chunkSize,
+			cvLen: cvLen,
+		},
+	}
+}
+
+// NewKangarooTwelve creates a new KangarooTwelve (KT128) ShakeHash with the
+// customization string custom, which may be empty. Its generic security
+// strength is 128 bits; Sum returns 32 bytes of output.
+//
+// Large writes have their leaves hashed concurrently on up to GOMAXPROCS
+// goroutines.
+func NewKangarooTwelve(custom []byte) ShakeHash {
+	return newKangarooTwelve(custom, rateK256, 32, 32, k12Rounds)
+}
+
+// NewKT256 creates a new KT256 ShakeHash with the customization string
+// custom, which may be empty. KT256 uses the same tree as KangarooTwelve
+// over a sponge with twice the capacity; its generic security strength is
+// 256 bits and Sum returns 64 bytes of output.
+//
+// Large writes have their leaves hashed concurrently on up to GOMAXPROCS
+// goroutines.
+func NewKT256(custom []byte) ShakeHash {
+	return newKangarooTwelve(custom, rateK512, 64, 64, k12Rounds)
+}
+
+// NewMarsupilamiFourteen creates a new MarsupilamiFourteen ShakeHash with
+// the customization string custom, which may be empty. It uses the same
+// tree as KT256 with two more rounds in the permutation, for users who want
+// a more conservative safety margin; its generic security strength is 256
+// bits and Sum returns 64 bytes of output.
+//
+// Large writes have their leaves hashed concurrently on up to GOMAXPROCS
+// goroutines.
+func NewMarsupilamiFourteen(custom []byte) ShakeHash {
+	return newKangarooTwelve(custom, rateK512, 64, 64, m14Rounds)
+}
+
+// lengthEncode returns x as the minimal number of big-endian bytes followed
+// by that number as a byte, so that zero is encoded as just 0x00.
+func lengthEncode(x uint64) []byte {
+	b := rightEncode(x)
+	if x == 0 {
+		b = b[1:]
+		b[0] = 0
+	}
+	return b
+}
+
+// BlockSize returns the size of the chunks the input is split into.
+func (k *kangarooTwelve) BlockSize() int { return k.chunkSize }
+
+// Size returns the number of bytes Sum will append.
+func (k *kangarooTwelve) Size() int { return k.final.outputLen }
+
+// Reset discards all data written so far.
+func (k *kangarooTwelve) Reset() {
+	k.final.Reset()
+	k.first = 0
+	k.tree = false
+	k.leaves.reset()
+}
+
+// Write absorbs more data into the hash's state. It panics if any output
+// has already been read.
+func (k *kangarooTwelve) Write(p []byte) (int, error) {
+	if k.final.state != spongeAbsorbing {
+		panic("keccak: Write after Read")
+	}
+	k.write(p)
+	return len(p), nil
+}
+
+func (k *kangarooTwelve) write(p []byte) {
+	if !k.tree {
+		x := min(len(p), k.chunkSize-k.first)
+		k.final.Write(p[:x])
+		k.first += x
+		p = p[x:]
+		if len(p) == 0 {
+			return
+		}
+		k.final.Write(sakuraHopSeparator[:])
+		k.tree = true
+	}
+	k.leaves.write(k.final, p)
+}
+
+// finalize appends the customization suffix and pads the final node.
+func (k *kangarooTwelve) finalize() {
+	k.write(k.custom)
+	if !k.tree {
+		k.final.dsbyte = k.coding.single
+		return
+	}
+	k.leaves.flush(k.final)
+	k.final.Write(appendSakuraChainingHop(nil, k.leaves.count))
+	k.final.dsbyte = k.coding.final
+}
+
+// Sum appends the digest of the data written so far to in. It does not
+// change the underlying state, and panics if any output has already been
+// read.
+func (k *kangarooTwelve) Sum(in []byte) []byte {
+	if k.final.state != spongeAbsorbing {
+		panic("keccak: Sum after Read")
+	}
+
+	dup := k.clone()
+	dup.finalize()
+	hash := make([]byte, dup.final.outputLen, 64)
+	_, _ = dup.final.Read(hash)
+	return append(in, hash...)
+}
+
+// Read squeezes an arbitrary number of bytes of output. The first call
+// finalizes the input, and subsequent calls to Write or Sum will panic.
+func (k *kangarooTwelve) Read(out []byte) (n int, err error) {
+	if k.final.state == spongeAbsorbing {
+		k.finalize()
+	}
+	return k.final.Read(out)
+}
+
+func (k *kangarooTwelve) clone() *kangarooTwelve {
+	ret := *k
+	ret.final = k.final.clone()
+	ret.leaves = k.leaves.clone()
+	return &ret
+}
+
+// Clone returns a copy of the KangarooTwelve instance in its current state.
+func (k *kangarooTwelve) Clone() ShakeHash {
+	return k.clone()
+}
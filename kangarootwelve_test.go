@@ -0,0 +1,153 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// ptn returns the pattern used by the RFC 9861 test vectors: n bytes
+// repeating 0x00, 0x01, ..., 0xFA.
+func ptn(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i % 251)
+	}
+	return b
+}
+
+// Test vectors from RFC 9861, Section 5.
+func TestKangarooTwelveVectors(t *testing.T) {
+	for _, tc := range []struct {
+		msg, custom []byte
+		want        string
+	}{
+		{nil, nil, "1ac2d450fc3b4205d19da7bfca1b37513c0803577ac7167f06fe2ce1f0ef39e5"},
+		{ptn(17), nil, "6bf75fa2239198db4772e36478f8e19b0f371205f6a9a93a273f51df37122888"},
+		{ptn(17 * 17), nil, "0c315ebcdedbf61426de7dcf8fb725d1e74675d7f5327a5067f367b108ecb67c"},
+		{ptn(17 * 17 * 17), nil, "cb552e2ec77d9910701d578b457ddf772c12e322e4ee7fe417f92c758f0d59d0"},
+		{ptn(17 * 17 * 17 * 17), nil, "8701045e22205345ff4dda05555cbb5c3af1a771c2b89baef37db43d9998b9fe"},
+		{ptn(17 * 17 * 17 * 17 * 17), nil, "844d610933b1b9963cbdeb5ae3b6b05cc7cbd67ceedf883eb678a0a8e0371682"},
+		{nil, ptn(1), "fab658db63e94a246188bf7af69a133045f46ee984c56e3c3328caaf1aa1a583"},
+		{[]byte{0xff}, ptn(41), "d848c5068ced736f4462159b9867fd4c20b808acc3d5bc48e0b06ba0a3762ec4"},
+		{bytes.Repeat([]byte{0xff}, 3), ptn(41 * 41), "c389e5009ae57120854c2e8c64670ac01358cf4c1baf89447a724234dc7ced74"},
+		{bytes.Repeat([]byte{0xff}, 7), ptn(41 * 41 * 41), "75d2f86a2e644566726b4fbcfc5657b9dbcf070c7b0dca06450ab291d7443bcf"},
+		// Either side of the single-node boundary.
+		{ptn(8191), nil, "1b577636f723643e990cc7d6a659837436fd6a103626600eb8301cd1dbe553d6"},
+		{ptn(8192), nil, "48f256f6772f9edfb6a8b661ec92dc93b95ebd05a08a17b39ae3490870c926c3"},
+	} {
+		h := NewKangarooTwelve(tc.custom)
+		h.Write(tc.msg)
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("KT128(%d bytes, %d bytes) = %s, want %s", len(tc.msg), len(tc.custom), got, tc.want)
+		}
+
+		// Byte-at-a-time writes agree with a single write.
+		h.Reset()
+		for i := range tc.msg {
+			h.Write(tc.msg[i : i+1])
+		}
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("KT128(%d bytes, %d bytes) with small writes = %s, want %s", len(tc.msg), len(tc.custom), got, tc.want)
+		}
+	}
+}
+
+func TestKangarooTwelveLongOutput(t *testing.T) {
+	// RFC 9861: KT128(M=empty, C=empty, 10032), last 32 bytes.
+	want := "e8dc563642f7228c84684c898405d3a834799158c079b12880277a1d28e2ff6d"
+	out := make([]byte, 10032)
+	h := NewKangarooTwelve(nil)
+	h.Read(out)
+	if got := hex.EncodeToString(out[len(out)-32:]); got != want {
+		t.Errorf("KT128 output bytes 10000-10031 = %s, want %s", got, want)
+	}
+}
+
+// Test vectors from RFC 9861, Section 5.
+func TestKT256Vectors(t *testing.T) {
+	for _, tc := range []struct {
+		msg, custom []byte
+		want        string
+	}{
+		{nil, nil, "b23d2e9cea9f4904e02bec06817fc10ce38ce8e93ef4c89e6537076af8646404e3e8b68107b8833a5d30490aa33482353fd4adc7148ecb782855003aaebde4a9"},
+		{ptn(17), nil, "1ba3c02b1fc514474f06c8979978a9056c8483f4a1b63d0dccefe3a28a2f323e1cdcca40ebf006ac76ef0397152346837b1277d3e7faa9c9653b19075098527b"},
+		{ptn(17 * 17), nil, "de8ccbc63e0f133ebb4416814d4c66f691bbf8b6a61ec0a7700f836b086cb029d54f12ac7159472c72db118c35b4e6aa213c6562caaa9dcc518959e69b10f3ba"},
+		{ptn(17 * 17 * 17), nil, "647efb49fe9d717500171b41e7f11bd491544443209997ce1c2530d15eb1ffbb598935ef954528ffc152b1e4d731ee2683680674365cd191d562bae753b84aa5"},
+		{ptn(17 * 17 * 17 * 17), nil, "b06275d284cd1cf205bcbe57dccd3ec1ff6686e3ed15776383e1f2fa3c6ac8f08bf8a162829db1a44b2a43ff83dd89c3cf1ceb61ede659766d5ccf817a62ba8d"},
+		{ptn(17 * 17 * 17 * 17 * 17), nil, "9473831d76a4c7bf77ace45b59f1458b1673d64bcd877a7c66b2664aa6dd149e60eab71b5c2bab858c074ded81ddce2b4022b5215935c0d4d19bf511aeeb0772"},
+		{nil, ptn(1), "9280f5cc39b54a5a594ec63de0bb99371e4609d44bf845c2f5b8c316d72b159811f748f23e3fabbe5c3226ec96c62186df2d33e9df74c5069ceecbb4dd10eff6"},
+		{[]byte{0xff}, ptn(41), "47ef96dd616f200937aa7847e34ec2feae8087e3761dc0f8c1a154f51dc9ccf845d7adbce57ff64b639722c6a1672e3bf5372d87e00aff89be97240756998853"},
+		{bytes.Repeat([]byte{0xff}, 3), ptn(41 * 41), "3b48667a5051c5966c53c5d42b95de451e05584e7806e2fb765eda959074172cb438a9e91dde337c98e9c41bed94c4e0aef431d0b64ef2324f7932caa6f54969"},
+		{bytes.Repeat([]byte{0xff}, 7), ptn(41 * 41 * 41), "e0911cc00025e1540831e266d94add9b98712142b80d2629e643aac4efaf5a3a30a88cbf4ac2a91a2432743054fbcc9897670e86ba8cec2fc2ace9c966369724"},
+	} {
+		h := NewKT256(tc.custom)
+		h.Write(tc.msg)
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("KT256(%d bytes, %d bytes) = %s, want %s", len(tc.msg), len(tc.custom), got, tc.want)
+		}
+	}
+}
+
+func TestKT256LongOutput(t *testing.T) {
+	// RFC 9861: KT256(M=empty, C=empty, 10064), last 64 bytes.
+	want := "ad4a1d718cf950506709a4c33396139b4449041fc79a05d68da35f1e453522e056c64fe94958e7085f2964888259b9932752f3ccd855288efee5fcbb8b563069"
+	out := make([]byte, 10064)
+	h := NewKT256(nil)
+	h.Read(out)
+	if got := hex.EncodeToString(out[len(out)-64:]); got != want {
+		t.Errorf("KT256 output bytes 10000-10063 = %s, want %s", got, want)
+	}
+}
+
+// MarsupilamiFourteen has no published test vectors; these are generated by
+// _gen/kangarootwelve.py, an independent implementation of the tree.
+func TestMarsupilamiFourteenVectors(t *testing.T) {
+	for _, tc := range []struct {
+		msg, custom []byte
+		want        string
+	}{
+		{nil, nil, "6f66ef1474eb53807aa329257c768bb88893d9f086e51da2f5c80d17ca0fc57d5a24fac879014f8b30a3fdf5ac56ebafa219eb891d4bbbab7e1df3b27205b459"},
+		{ptn(17), nil, "aa764fd8b38f19976a305cb007f19384b210a5c7b0fc4499d6f83c6227bff850270b880cff3f17325b843e972ae0b99a25fa0e0050cc748f37c4cfc2592fd172"},
+		{ptn(17 * 17 * 17), nil, "0ac89b11a06f46b2f6feeff046c97e90dc02910ae509b8739cfea5df1df90b82895a5fad67ad2fa41259090756c0d988440fa3267a48380ada5df9c7f0290757"},
+		{ptn(17 * 17 * 17 * 17), nil, "35af0a5fc6c4d111fbc68f879d05506aafd300b5ab136986d7aed8a9f1be331e8664381864672e81ba32d828b2c05192a5886846f6c7570e7ebaeb97b59bd73e"},
+		{bytes.Repeat([]byte{0xff}, 3), ptn(41 * 41), "732a60c308bebf5f7b3d3e8f0d26e324c04bab4197ca0a608b0befaa25ea59760718509c01fe503de2b970963f31e359e31f6ad5f6a591e83bc641d4cd6411dd"},
+	} {
+		h := NewMarsupilamiFourteen(tc.custom)
+		h.Write(tc.msg)
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("M14(%d bytes, %d bytes) = %s, want %s", len(tc.msg), len(tc.custom), got, tc.want)
+		}
+	}
+}
+
+func TestKangarooTwelveClone(t *testing.T) {
+	h := NewKangarooTwelve([]byte("custom"))
+	h.Write(ptn(20000))
+	c := h.Clone()
+	h.Write(ptn(100))
+	c.Write(ptn(100))
+
+	a := make([]byte, 64)
+	b := make([]byte, 64)
+	h.Read(a)
+	c.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("clone produced different output")
+	}
+}
+
+func BenchmarkKangarooTwelve_1M(b *testing.B) {
+	data := make([]byte, 1<<20)
+	b.SetBytes(int64(len(data)))
+	h := NewKangarooTwelve(nil)
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
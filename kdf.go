@@ -0,0 +1,39 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the KMAC-based key derivation functions of NIST
+// SP 800-108r1 [1], Section 4.4. Since KMAC takes the output length as an
+// input and produces output of any length, the KDF is a single KMAC call
+// rather than an iteration of a PRF in counter mode:
+//
+//	K_OUT = KMAC(K_IN, Context, L, Label)
+//
+// [1] https://doi.org/10.6028/NIST.SP.800-108r1-upd1
+
+import "hash"
+
+// KMACKDF128 derives a key of length bytes from key, label and context
+// with KMAC128, as specified in NIST SP 800-108r1. It panics if length is
+// not positive.
+func KMACKDF128(key, label, context []byte, length int) []byte {
+	return kmacKDF(NewKMAC128, key, label, context, length)
+}
+
+// KMACKDF256 derives a key of length bytes from key, label and context
+// with KMAC256, as specified in NIST SP 800-108r1. It panics if length is
+// not positive.
+func KMACKDF256(key, label, context []byte, length int) []byte {
+	return kmacKDF(NewKMAC256, key, label, context, length)
+}
+
+func kmacKDF(newKMAC func(key, S []byte, outputLen int) hash.Hash, key, label, context []byte, length int) []byte {
+	if length <= 0 {
+		panic("keccak: invalid KDF output length")
+	}
+	h := newKMAC(key, label, length)
+	h.Write(context)
+	return h.Sum(nil)
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Keys generated by _gen/kdf.py, an independent implementation of KMAC.
+func TestKMACKDF(t *testing.T) {
+	key := ptn(32)
+	got := KMACKDF128(key, []byte("label"), []byte("context"), 32)
+	if want := "f8308b1c84712d903750cab7f19ddc556f8bbab12928db8bfc63803b1995eb74"; hex.EncodeToString(got) != want {
+		t.Errorf("KMACKDF128 = %x, want %s", got, want)
+	}
+	got = KMACKDF256(key, []byte("label"), []byte("context"), 64)
+	if want := "d59e1f1a24bdb055b0af798580af3da4086bc27b7cf7adaa1f6ae066a6f17bcd78a17262c421e6964fd9170838e7206eaded1a7811784396867ec475f67047bd"; hex.EncodeToString(got) != want {
+		t.Errorf("KMACKDF256 = %x, want %s", got, want)
+	}
+
+	// The length is bound into the output.
+	short := KMACKDF256(key, []byte("label"), []byte("context"), 32)
+	if hex.EncodeToString(short) == hex.EncodeToString(got[:32]) {
+		t.Error("a shorter key is a prefix of a longer one")
+	}
+}
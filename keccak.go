@@ -2,8 +2,10 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package keccak provides the legacy Keccak-256 and Keccak-512 hash functions
-// using the pre-standardization domain separator (0x01 instead of SHA-3's 0x06).
+// Package keccak provides the legacy Keccak-224, Keccak-256, Keccak-384 and
+// Keccak-512 hash functions using the pre-standardization domain separator
+// (0x01 instead of SHA-3's 0x06), along with the SHA-3 hash functions and the
+// SHAKE and cSHAKE extendable-output functions built on the same sponge.
 //
 // This package vendors the sponge construction and amd64 assembly permutation
 // from golang.org/x/crypto/sha3 at v0.43.0, the last version that included the
@@ -16,9 +18,11 @@
 package keccak
 
 import (
-	"crypto/subtle"
 	"encoding/binary"
 	"errors"
+	"io"
+	"slices"
+	"sync"
 	"unsafe"
 )
 
@@ -37,10 +41,16 @@ const (
 
 const (
 	dsbyteKeccak = 0b00000001
+	dsbyteSHA3   = 0b00000110
+	dsbyteShake  = 0b00011111
+	dsbyteCShake = 0b00000100
 
 	// rateK[c] is the rate in bytes for Keccak[c] where c is the capacity in
 	// bits. Given the sponge size is 1600 bits, the rate is 1600 - c bits.
+	rateK256  = (1600 - 256) / 8
+	rateK448  = (1600 - 448) / 8
 	rateK512  = (1600 - 512) / 8
+	rateK768  = (1600 - 768) / 8
 	rateK1024 = (1600 - 1024) / 8
 )
 
@@ -68,6 +78,22 @@ type state struct {
 
 	outputLen int             // the default output size in bytes
 	state     spongeDirection // whether the sponge is absorbing or squeezing
+
+	// counts is the work done by the sponge, with the keccak_counters tag.
+	counts stateCounters
+
+	// rounds is the number of rounds of the Keccak-p[1600] permutation, or
+	// zero for the full 24 rounds of Keccak-f[1600].
+	rounds int
+
+	// turboShake marks the states of TurboSHAKE, which are marshaled with
+	// their domain separation byte.
+	turboShake bool
+
+	// width is the size in bytes of the narrow Keccak-f[800], Keccak-f[400]
+	// or Keccak-f[200] permutation, or zero for Keccak-f[1600]. Only the
+	// first width bytes of a are used by the narrow permutations.
+	width int
 }
 
 // BlockSize returns the rate of sponge underlying this hash function.
@@ -88,12 +114,25 @@ func (d *state) Reset() {
 }
 
 func (d *state) clone() *state {
-	ret := *d
-	return &ret
+	return newState(*d)
 }
 
-// permute applies the KeccakF-1600 permutation.
+// permute applies the KeccakF-1600 permutation, or the round-reduced
+// Keccak-p[1600] permutation if d.rounds is set.
 func (d *state) permute() {
+	if d.width != 0 {
+		d.permuteNarrow()
+		return
+	}
+	d.counts.permuted(1)
+	if useKIMD && d.rounds == 0 {
+		// KIMD works on the state in its byte order, so there is nothing
+		// to convert.
+		kimdPermute(&d.a)
+		d.n = 0
+		return
+	}
+
 	var a *[25]uint64
 	if isBigEndian {
 		a = new([25]uint64)
@@ -104,7 +143,11 @@ func (d *state) permute() {
 		a = (*[25]uint64)(unsafe.Pointer(&d.a))
 	}
 
-	keccakF1600(a)
+	if d.rounds == 0 {
+		keccakF1600(a)
+	} else {
+		permute1600(a, d.rounds)
+	}
 	d.n = 0
 
 	if isBigEndian {
@@ -133,15 +176,25 @@ func (d *state) padAndPermute() {
 
 // Write absorbs more data into the hash's state. It panics if any
 // output has already been read.
+//
+// The sponge state doubles as the input buffer: input is XORed into it
+// straight from p, whole blocks included, so there is no copy to avoid.
 func (d *state) Write(p []byte) (n int, err error) {
 	if d.state != spongeAbsorbing {
 		panic("keccak: Write after Read")
 	}
 
 	n = len(p)
+	d.counts.absorbedBytes(n)
 
 	for len(p) > 0 {
-		x := subtle.XORBytes(d.a[d.n:d.rate], d.a[d.n:d.rate], p)
+		if useKIMD && d.n == 0 && d.rounds == 0 && d.width == 0 {
+			x := kimdAbsorb(&d.a, d.rate, p)
+			d.counts.permuted(x / d.rate)
+			p = p[x:]
+		}
+
+		x := xorIn(d.a[d.n:d.rate], p)
 		d.n += x
 		p = p[x:]
 
@@ -154,6 +207,49 @@ func (d *state) Write(p []byte) (n int, err error) {
 	return
 }
 
+// WriteString absorbs the bytes of s, like Write, without the allocation
+// of converting s to a byte slice. It implements io.StringWriter, so that
+// io.WriteString and fmt.Fprint use it.
+func (d *state) WriteString(s string) (n int, err error) {
+	// Write only reads from its argument, so s cannot be modified.
+	return d.Write(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// readFromSize is the size of the buffers ReadFrom reads into.
+const readFromSize = 16 << 10
+
+var readFromBufs = sync.Pool{
+	New: func() any { return new([readFromSize]byte) },
+}
+
+// ReadFrom absorbs data from r until EOF or an error, and returns the number
+// of bytes absorbed. It panics if any output has already been read.
+//
+// It implements io.ReaderFrom, so that io.Copy to the hash reads into a
+// pooled buffer, a whole number of blocks long, rather than allocating one
+// of 32 KiB on every call.
+func (d *state) ReadFrom(r io.Reader) (n int64, err error) {
+	if d.state != spongeAbsorbing {
+		panic("keccak: ReadFrom after Read")
+	}
+
+	buf := readFromBufs.Get().(*[readFromSize]byte)
+	defer readFromBufs.Put(buf)
+	b := buf[:len(buf)-len(buf)%d.rate]
+	for {
+		var m int
+		m, err = io.ReadFull(r, b)
+		d.Write(b[:m])
+		n += int64(m)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
 // Read squeezes an arbitrary number of bytes from the sponge.
 func (d *state) Read(out []byte) (n int, err error) {
 	// If we're still absorbing, pad and apply the permutation.
@@ -162,11 +258,16 @@ func (d *state) Read(out []byte) (n int, err error) {
 	}
 
 	n = len(out)
+	d.counts.squeezedBytes(n)
 
 	// Now, do the squeezing.
 	for len(out) > 0 {
-		// Apply the permutation if we've squeezed the sponge dry.
+		// Apply the permutation if we've squeezed the sponge dry, after
+		// squeezing any whole blocks straight into out.
 		if d.n == d.rate {
+			if out = d.squeezeBlocks(out); len(out) == 0 {
+				break
+			}
 			d.permute()
 		}
 
@@ -178,6 +279,48 @@ func (d *state) Read(out []byte) (n int, err error) {
 	return
 }
 
+// squeezeBlocks squeezes as many whole blocks as fit into out, which must
+// start on a block boundary of the output, and returns the rest of out.
+func (d *state) squeezeBlocks(out []byte) []byte {
+	if len(out) < d.rate {
+		return out
+	}
+	if !isBigEndian || useKIMD && d.rounds == 0 || d.width != 0 || d.rate%8 != 0 {
+		for len(out) >= d.rate {
+			d.permute()
+			copy(out, d.a[:d.rate])
+			out = out[d.rate:]
+		}
+		d.n = d.rate
+		return out
+	}
+
+	// On big-endian hosts, permute converts the state to lanes and back for
+	// every block. Here it is converted once, and the lanes of each block
+	// are stored straight into out.
+	var a [25]uint64
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(d.a[i*8:])
+	}
+	for len(out) >= d.rate {
+		d.counts.permuted(1)
+		if d.rounds == 0 {
+			keccakF1600(&a)
+		} else {
+			permute1600(&a, d.rounds)
+		}
+		for i := 0; i < d.rate/8; i++ {
+			binary.LittleEndian.PutUint64(out[i*8:], a[i])
+		}
+		out = out[d.rate:]
+	}
+	for i := range a {
+		binary.LittleEndian.PutUint64(d.a[i*8:], a[i])
+	}
+	d.n = d.rate
+	return out
+}
+
 // Sum applies padding to the hash state and then squeezes out the desired
 // number of output bytes. It panics if any output has already been read.
 func (d *state) Sum(in []byte) []byte {
@@ -188,15 +331,85 @@ func (d *state) Sum(in []byte) []byte {
 	// Make a copy of the original hash so that caller can keep writing
 	// and summing.
 	dup := d.clone()
-	hash := make([]byte, dup.outputLen, 64) // explicit cap to allow stack allocation
+	var hash []byte
+	if dup.outputLen <= 64 {
+		hash = make([]byte, dup.outputLen, 64) // explicit cap to allow stack allocation
+	} else {
+		hash = make([]byte, dup.outputLen)
+	}
 	_, _ = dup.Read(hash)
+	d.counts = dup.counts
 	return append(in, hash...)
 }
 
+// AppendSum appends the digest of the data written so far to dst and
+// returns the resulting slice. Like Sum, it does not change the underlying
+// hash state, and it panics if any output has already been read. Unlike Sum,
+// it never allocates if dst has room for the digest, as it squeezes the
+// digest straight into it.
+func (d *state) AppendSum(dst []byte) []byte {
+	if d.state != spongeAbsorbing {
+		panic("keccak: AppendSum after Read")
+	}
+
+	n := len(dst)
+	dst = slices.Grow(dst, d.outputLen)[:n+d.outputLen]
+	d.sumInto(dst[n:])
+	return dst
+}
+
+// Sum256 returns the digest of the data written so far, for hashes with a
+// 32-byte output such as Keccak-256, SHA3-256 and SHAKE128. Like Sum, it does
+// not change the underlying hash state, and it panics if any output has
+// already been read. It also panics if the hash has a different output
+// length.
+func (d *state) Sum256() (digest [32]byte) {
+	if d.state != spongeAbsorbing {
+		panic("keccak: Sum256 after Read")
+	}
+	if d.outputLen != len(digest) {
+		panic("keccak: Sum256 of a hash with a different output length")
+	}
+	d.sumInto(digest[:])
+	return
+}
+
+// Sum512 returns the digest of the data written so far, for hashes with a
+// 64-byte output such as Keccak-512, SHA3-512 and SHAKE256. Like Sum, it does
+// not change the underlying hash state, and it panics if any output has
+// already been read. It also panics if the hash has a different output
+// length.
+func (d *state) Sum512() (digest [64]byte) {
+	if d.state != spongeAbsorbing {
+		panic("keccak: Sum512 after Read")
+	}
+	if d.outputLen != len(digest) {
+		panic("keccak: Sum512 of a hash with a different output length")
+	}
+	d.sumInto(digest[:])
+	return
+}
+
+// sumInto squeezes len(out) bytes into out from a copy of the state, which
+// is kept on the stack.
+func (d *state) sumInto(out []byte) {
+	dup := *d
+	_, _ = dup.Read(out)
+	d.counts = dup.counts
+}
+
 const (
+	magicSHA3   = "sha\x08"
+	magicShake  = "sha\x09"
+	magicCShake = "sha\x0a"
 	magicKeccak = "sha\x0b"
+	// magicTurboShake identifies TurboSHAKE states, which are followed by
+	// their domain separation byte.
+	magicTurboShake = "sha\x0c"
 	// magic || rate || main state || n || sponge direction
 	marshaledSize = len(magicKeccak) + 1 + 200 + 1 + 1
+	// marshaledSize || D
+	marshaledTurboShakeSize = marshaledSize + 1
 )
 
 func (d *state) MarshalBinary() ([]byte, error) {
@@ -204,22 +417,55 @@ func (d *state) MarshalBinary() ([]byte, error) {
 }
 
 func (d *state) AppendBinary(b []byte) ([]byte, error) {
-	b = append(b, magicKeccak...)
+	switch {
+	case d.width != 0:
+		return nil, errors.New("keccak: cannot marshal the state of a narrow sponge")
+	case d.turboShake:
+		b = append(b, magicTurboShake...)
+	case d.rounds != 0:
+		return nil, errors.New("keccak: cannot marshal the state of a round-reduced sponge")
+	case d.dsbyte == dsbyteSHA3:
+		b = append(b, magicSHA3...)
+	case d.dsbyte == dsbyteShake:
+		b = append(b, magicShake...)
+	case d.dsbyte == dsbyteCShake:
+		b = append(b, magicCShake...)
+	case d.dsbyte == dsbyteKeccak:
+		b = append(b, magicKeccak...)
+	default:
+		return nil, errors.New("keccak: cannot marshal the state of a sponge with a custom domain separation byte")
+	}
 	// rate is at most 168, and n is at most rate.
 	b = append(b, byte(d.rate))
 	b = append(b, d.a[:]...)
 	b = append(b, byte(d.n), byte(d.state))
+	if d.turboShake {
+		b = append(b, d.dsbyte)
+	}
 	return b, nil
 }
 
 func (d *state) UnmarshalBinary(b []byte) error {
-	if len(b) != marshaledSize {
+	size := marshaledSize
+	if d.turboShake {
+		size = marshaledTurboShakeSize
+	}
+	if len(b) != size || d.width != 0 {
 		return errors.New("keccak: invalid hash state")
 	}
 
 	magic := string(b[:len(magicKeccak)])
 	b = b[len(magicKeccak):]
-	if magic != magicKeccak || d.dsbyte != dsbyteKeccak {
+	switch {
+	case magic == magicTurboShake && d.turboShake:
+		if b[len(b)-1] != d.dsbyte {
+			return errors.New("keccak: invalid hash state domain separation byte")
+		}
+	case magic == magicSHA3 && d.dsbyte == dsbyteSHA3 && d.rounds == 0:
+	case magic == magicShake && d.dsbyte == dsbyteShake && d.rounds == 0:
+	case magic == magicCShake && d.dsbyte == dsbyteCShake && d.rounds == 0:
+	case magic == magicKeccak && d.dsbyte == dsbyteKeccak && d.rounds == 0:
+	default:
 		return errors.New("keccak: invalid hash state identifier")
 	}
 
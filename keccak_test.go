@@ -5,9 +5,13 @@
 package keccak
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"hash"
+	"io"
 	"testing"
+	"testing/iotest"
 )
 
 // Test vectors from the Keccak reference implementation.
@@ -35,6 +39,28 @@ func TestKeccak256ABC(t *testing.T) {
 	}
 }
 
+func TestKeccak224(t *testing.T) {
+	for _, tc := range []struct{ msg, want string }{
+		{"", "f71837502ba8e10837bdd8d365adb85591895602fc552b48b7390abd"},
+		{"abc", "c30411768506ebe1c2871b1ee2e87d38df342317300a9b97a95ec6a8"},
+	} {
+		if got := singleShotHash(NewLegacyKeccak224, []byte(tc.msg)); got != tc.want {
+			t.Errorf("Keccak224(%q) = %s, want %s", tc.msg, got, tc.want)
+		}
+	}
+}
+
+func TestKeccak384(t *testing.T) {
+	for _, tc := range []struct{ msg, want string }{
+		{"", "2c23146a63a29acf99e73b88f8c24eaa7dc60aa771780ccc006afbfa8fe2479b2dd2b21362337441ac12b515911957ff"},
+		{"abc", "f7df1165f033337be098e7d288ad6a2f74409d7a60b49c36642218de161b1f99f8c681e4afaf31a34db29fb763e3c28e"},
+	} {
+		if got := singleShotHash(NewLegacyKeccak384, []byte(tc.msg)); got != tc.want {
+			t.Errorf("Keccak384(%q) = %s, want %s", tc.msg, got, tc.want)
+		}
+	}
+}
+
 func TestKeccak512Empty(t *testing.T) {
 	// Keccak-512 of empty input
 	expected := "0eab42de4c3ceb9235fc91acffe746b29c29a8c366b7c60e4e67c466f36a4304c00fa9caf9d87976ba469bcbe06713b435f091ef2769fb160cdab33d3670680e"
@@ -116,6 +142,21 @@ func TestKeccak256BlockSize(t *testing.T) {
 	}
 }
 
+func TestKeccak224And384Sizes(t *testing.T) {
+	for _, tc := range []struct {
+		newFunc         func() hash.Hash
+		size, blockSize int
+	}{
+		{NewLegacyKeccak224, 28, 144},
+		{NewLegacyKeccak384, 48, 104},
+	} {
+		h := tc.newFunc()
+		if h.Size() != tc.size || h.BlockSize() != tc.blockSize {
+			t.Errorf("Size(), BlockSize() = %d, %d, want %d, %d", h.Size(), h.BlockSize(), tc.size, tc.blockSize)
+		}
+	}
+}
+
 func TestKeccak256MarshalUnmarshal(t *testing.T) {
 	h1 := NewLegacyKeccak256()
 	h1.Write([]byte("hello"))
@@ -162,6 +203,251 @@ func TestKeccak256EthereumAddress(t *testing.T) {
 	}
 }
 
+func TestSum256(t *testing.T) {
+	for _, msg := range []string{"", "abc", string(make([]byte, 1000))} {
+		want := singleShotHash(NewLegacyKeccak256, []byte(msg))
+		sum := Sum256([]byte(msg))
+		if got := hex.EncodeToString(sum[:]); got != want {
+			t.Errorf("Sum256(%d bytes) = %s, want %s", len(msg), got, want)
+		}
+	}
+}
+
+func TestSum512(t *testing.T) {
+	for _, msg := range []string{"", "abc", string(make([]byte, 1000))} {
+		want := singleShotHash(NewLegacyKeccak512, []byte(msg))
+		sum := Sum512([]byte(msg))
+		if got := hex.EncodeToString(sum[:]); got != want {
+			t.Errorf("Sum512(%d bytes) = %s, want %s", len(msg), got, want)
+		}
+	}
+}
+
+// Inputs shorter than a block take a shortcut; check it against the sponge
+// on both sides of the block boundary.
+func TestSumSingleBlock(t *testing.T) {
+	for n := 0; n <= rateK512+1; n++ {
+		msg := ptn(n)
+		sum := Sum256(msg)
+		if got, want := hex.EncodeToString(sum[:]), singleShotHash(NewLegacyKeccak256, msg); got != want {
+			t.Errorf("Sum256(%d bytes) = %s, want %s", n, got, want)
+		}
+		if n > rateK1024+1 {
+			continue
+		}
+		sum512 := Sum512(msg)
+		if got, want := hex.EncodeToString(sum512[:]), singleShotHash(NewLegacyKeccak512, msg); got != want {
+			t.Errorf("Sum512(%d bytes) = %s, want %s", n, got, want)
+		}
+	}
+}
+
+func TestSumAllocs(t *testing.T) {
+	for _, size := range []int{32, 200} {
+		data := make([]byte, size)
+		if n := testing.AllocsPerRun(10, func() { Sum256(data) }); n > 0 {
+			t.Errorf("Sum256(%d bytes) allocated %v times, want 0", size, n)
+		}
+		if n := testing.AllocsPerRun(10, func() { Sum512(data) }); n > 0 {
+			t.Errorf("Sum512(%d bytes) allocated %v times, want 0", size, n)
+		}
+	}
+}
+
+func TestAppendSum(t *testing.T) {
+	for _, newHash := range []func() hash.Hash{NewLegacyKeccak256, NewLegacyKeccak512, New224, New384} {
+		h := newHash()
+		h.Write([]byte("abc"))
+		prefix := []byte("prefix")
+		got := h.(*state).AppendSum(prefix)
+		if want := h.Sum([]byte("prefix")); !bytes.Equal(got, want) {
+			t.Errorf("AppendSum = %x, want %x", got, want)
+		}
+
+		// The state is unchanged, so writing can go on.
+		h.Write([]byte("def"))
+		got = h.(*state).AppendSum(nil)
+		if want := h.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("AppendSum after a further write = %x, want %x", got, want)
+		}
+	}
+
+	sum := Sum256([]byte("abc"))
+	if got, want := AppendSum256([]byte("prefix"), []byte("abc")), append([]byte("prefix"), sum[:]...); !bytes.Equal(got, want) {
+		t.Errorf("AppendSum256 = %x, want %x", got, want)
+	}
+}
+
+func TestAppendSumAllocs(t *testing.T) {
+	data := make([]byte, 200)
+	dst := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(10, func() { AppendSum256(dst, data) }); n > 0 {
+		t.Errorf("AppendSum256 allocated %v times, want 0", n)
+	}
+	h := NewLegacyKeccak256().(*state)
+	h.Write(data)
+	if n := testing.AllocsPerRun(10, func() { h.AppendSum(dst) }); n > 0 {
+		t.Errorf("AppendSum allocated %v times, want 0", n)
+	}
+}
+
+func TestSumMethods(t *testing.T) {
+	for _, newHash := range []func() hash.Hash{NewLegacyKeccak256, New256, func() hash.Hash { return NewShake128() }} {
+		h := newHash()
+		h.Write([]byte("abc"))
+		got := h.(*state).Sum256()
+		if want := h.Sum(nil); !bytes.Equal(got[:], want) {
+			t.Errorf("Sum256 = %x, want %x", got, want)
+		}
+		h.Write([]byte("def"))
+		got = h.(*state).Sum256()
+		if want := h.Sum(nil); !bytes.Equal(got[:], want) {
+			t.Errorf("Sum256 after a further write = %x, want %x", got, want)
+		}
+	}
+	for _, newHash := range []func() hash.Hash{NewLegacyKeccak512, New512, func() hash.Hash { return NewShake256() }} {
+		h := newHash()
+		h.Write([]byte("abc"))
+		got := h.(*state).Sum512()
+		if want := h.Sum(nil); !bytes.Equal(got[:], want) {
+			t.Errorf("Sum512 = %x, want %x", got, want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Sum256 of Keccak-512 did not panic")
+		}
+	}()
+	NewLegacyKeccak512().(*state).Sum256()
+}
+
+func TestSumMethodsAllocs(t *testing.T) {
+	h := NewLegacyKeccak256().(*state)
+	h.Write(make([]byte, 200))
+	if n := testing.AllocsPerRun(10, func() { h.Sum256() }); n > 0 {
+		t.Errorf("Sum256 allocated %v times, want 0", n)
+	}
+	h = NewLegacyKeccak512().(*state)
+	if n := testing.AllocsPerRun(10, func() { h.Sum512() }); n > 0 {
+		t.Errorf("Sum512 allocated %v times, want 0", n)
+	}
+}
+
+func TestLegacyKeccakXOF(t *testing.T) {
+	// Outputs generated by _gen/sponge.py.
+	for _, tc := range []struct {
+		name   string
+		newXOF func() ShakeHash
+		digest []byte
+		outLen int
+		want   string
+	}{
+		{"XOF256", NewLegacyKeccakXOF256, keccak256([]byte("abc")), 300, "23bb9a32b0077e4f4d7d3173d57c6a38237052b2bba012499ac3e1b404146611"},
+		{"XOF512", NewLegacyKeccakXOF512, keccak512([]byte("abc")), 200, "8096d1e30368503d626b4814f14179665cdb5a00fa54ec57154c008e30edcb82"},
+	} {
+		h := tc.newXOF()
+		h.Write([]byte("abc"))
+		if got := h.Sum(nil); !bytes.Equal(got, tc.digest) {
+			t.Errorf("%s: Sum = %x, want the legacy Keccak digest %x", tc.name, got, tc.digest)
+		}
+		out := make([]byte, tc.outLen)
+		h.Read(out)
+		if !bytes.HasPrefix(out, tc.digest) {
+			t.Errorf("%s: output does not start with the legacy Keccak digest", tc.name)
+		}
+		if got := hex.EncodeToString(out[tc.outLen-32:]); got != tc.want {
+			t.Errorf("%s: end of output = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func keccak256(data []byte) []byte {
+	d := Sum256(data)
+	return d[:]
+}
+
+func keccak512(data []byte) []byte {
+	d := Sum512(data)
+	return d[:]
+}
+
+func TestKeccakP1600Rounds(t *testing.T) {
+	// keccakP1600 with any round count agrees with the last rounds of the
+	// permutation applied one at a time.
+	for rounds := 0; rounds <= 24; rounds++ {
+		var a, b [25]uint64
+		for i := range a {
+			a[i] = uint64(i) * 0x9e3779b97f4a7c15
+		}
+		b = a
+		keccakP1600(&a, rounds)
+		for i := 24 - rounds; i < 24; i++ {
+			keccakRound(&b, rc[i])
+		}
+		if a != b {
+			t.Errorf("keccakP1600(%d rounds) disagrees with keccakRound", rounds)
+		}
+	}
+}
+
+func TestWriteString(t *testing.T) {
+	for _, size := range []int{0, 3, 136, 1000} {
+		msg := string(ptn(size))
+		h := NewLegacyKeccak256()
+		io.WriteString(h, "x")
+		io.WriteString(h, msg)
+		want := NewLegacyKeccak256()
+		want.Write([]byte("x" + msg))
+		if got, want := h.Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("WriteString(%d bytes) hashed to %x, want %x", size, got, want)
+		}
+	}
+
+	h := NewLegacyKeccak256()
+	msg := "transfer(address,uint256)"
+	if n := testing.AllocsPerRun(10, func() { io.WriteString(h, msg) }); n > 0 {
+		t.Errorf("WriteString allocated %v times, want 0", n)
+	}
+}
+
+func TestReadFrom(t *testing.T) {
+	for _, size := range []int{0, 1, 135, 136, 137, readFromSize - 1, readFromSize, 3*readFromSize + 100} {
+		data := ptn(size)
+		h := NewLegacyKeccak256()
+		h.Write([]byte("x"))
+		n, err := io.Copy(h, struct{ io.Reader }{bytes.NewReader(data)})
+		if n != int64(size) || err != nil {
+			t.Errorf("io.Copy(%d bytes) = %d, %v", size, n, err)
+		}
+		want := NewLegacyKeccak256()
+		want.Write([]byte("x"))
+		want.Write(data)
+		if got, want := h.Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("io.Copy(%d bytes) hashed to %x, want %x", size, got, want)
+		}
+	}
+
+	errRead := errors.New("read error")
+	h := NewLegacyKeccak256().(*state)
+	r := io.MultiReader(bytes.NewReader(ptn(1000)), iotest.ErrReader(errRead))
+	if n, err := h.ReadFrom(r); n != 1000 || err != errRead {
+		t.Errorf("ReadFrom = %d, %v, want 1000, %v", n, err, errRead)
+	}
+}
+
+func TestReadFromAllocs(t *testing.T) {
+	h := NewLegacyKeccak256()
+	br := bytes.NewReader(make([]byte, 100000))
+	var r io.Reader = struct{ io.Reader }{br}
+	if n := testing.AllocsPerRun(10, func() {
+		br.Seek(0, io.SeekStart)
+		io.Copy(h, r)
+	}); n > 0 {
+		t.Errorf("io.Copy allocated %v times, want 0", n)
+	}
+}
+
 func BenchmarkKeccak256_32(b *testing.B) {
 	benchmarkHash(b, NewLegacyKeccak256, 32)
 }
@@ -174,6 +460,21 @@ func BenchmarkKeccak256_8K(b *testing.B) {
 	benchmarkHash(b, NewLegacyKeccak256, 8192)
 }
 
+// BenchmarkKeccak256Stream hashes a stream read by io.Copy.
+func BenchmarkKeccak256Stream(b *testing.B) {
+	const size = 1 << 20
+	b.SetBytes(size)
+	data := make([]byte, size)
+	h := NewLegacyKeccak256()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		// Hide the WriterTo method of bytes.Reader, so that io.Copy goes
+		// through the ReadFrom method of the hash.
+		io.Copy(h, struct{ io.Reader }{bytes.NewReader(data)})
+		h.Sum(nil)
+	}
+}
+
 func benchmarkHash(b *testing.B, newFunc func() hash.Hash, size int) {
 	b.SetBytes(int64(size))
 	data := make([]byte, size)
@@ -185,3 +486,11 @@ func benchmarkHash(b *testing.B, newFunc func() hash.Hash, size int) {
 		h.Sum(nil)
 	}
 }
+
+func BenchmarkSum256_32(b *testing.B) {
+	b.SetBytes(32)
+	data := make([]byte, 32)
+	for i := 0; i < b.N; i++ {
+		Sum256(data)
+	}
+}
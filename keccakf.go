@@ -2,8 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !amd64 || purego || !gc
-
 package keccak
 
 import "math/bits"
@@ -36,14 +34,107 @@ var rc = [24]uint64{
 	0x8000000080008008,
 }
 
-// keccakF1600 applies the Keccak permutation to a 1600b-wide
-// state represented as a slice of 25 uint64s.
-func keccakF1600(a *[25]uint64) {
+// rho stores the rotation offsets for use in the ρ step, indexed by x+5y.
+var rho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// keccakRound applies a single round of the Keccak permutation, using the
+// round constant c. It is used for round counts that are not a multiple of
+// the four rounds unrolled by keccakP1600, and is unrolled likewise: a round
+// written with loops over the lanes took several times as long as a round
+// of keccakP1600.
+func keccakRound(a *[25]uint64, c uint64) {
+	// θ step
+	c0 := a[0] ^ a[5] ^ a[10] ^ a[15] ^ a[20]
+	c1 := a[1] ^ a[6] ^ a[11] ^ a[16] ^ a[21]
+	c2 := a[2] ^ a[7] ^ a[12] ^ a[17] ^ a[22]
+	c3 := a[3] ^ a[8] ^ a[13] ^ a[18] ^ a[23]
+	c4 := a[4] ^ a[9] ^ a[14] ^ a[19] ^ a[24]
+	d0 := c4 ^ bits.RotateLeft64(c1, 1)
+	d1 := c0 ^ bits.RotateLeft64(c2, 1)
+	d2 := c1 ^ bits.RotateLeft64(c3, 1)
+	d3 := c2 ^ bits.RotateLeft64(c4, 1)
+	d4 := c3 ^ bits.RotateLeft64(c0, 1)
+
+	// ρ and π steps
+	b0 := a[0] ^ d0
+	b1 := bits.RotateLeft64(a[6]^d1, 44)
+	b2 := bits.RotateLeft64(a[12]^d2, 43)
+	b3 := bits.RotateLeft64(a[18]^d3, 21)
+	b4 := bits.RotateLeft64(a[24]^d4, 14)
+	b5 := bits.RotateLeft64(a[3]^d3, 28)
+	b6 := bits.RotateLeft64(a[9]^d4, 20)
+	b7 := bits.RotateLeft64(a[10]^d0, 3)
+	b8 := bits.RotateLeft64(a[16]^d1, 45)
+	b9 := bits.RotateLeft64(a[22]^d2, 61)
+	b10 := bits.RotateLeft64(a[1]^d1, 1)
+	b11 := bits.RotateLeft64(a[7]^d2, 6)
+	b12 := bits.RotateLeft64(a[13]^d3, 25)
+	b13 := bits.RotateLeft64(a[19]^d4, 8)
+	b14 := bits.RotateLeft64(a[20]^d0, 18)
+	b15 := bits.RotateLeft64(a[4]^d4, 27)
+	b16 := bits.RotateLeft64(a[5]^d0, 36)
+	b17 := bits.RotateLeft64(a[11]^d1, 10)
+	b18 := bits.RotateLeft64(a[17]^d2, 15)
+	b19 := bits.RotateLeft64(a[23]^d3, 56)
+	b20 := bits.RotateLeft64(a[2]^d2, 62)
+	b21 := bits.RotateLeft64(a[8]^d3, 55)
+	b22 := bits.RotateLeft64(a[14]^d4, 39)
+	b23 := bits.RotateLeft64(a[15]^d0, 41)
+	b24 := bits.RotateLeft64(a[21]^d1, 2)
+
+	// χ and ι steps
+	a[0] = b0 ^ (b2 &^ b1) ^ c
+	a[1] = b1 ^ (b3 &^ b2)
+	a[2] = b2 ^ (b4 &^ b3)
+	a[3] = b3 ^ (b0 &^ b4)
+	a[4] = b4 ^ (b1 &^ b0)
+	a[5] = b5 ^ (b7 &^ b6)
+	a[6] = b6 ^ (b8 &^ b7)
+	a[7] = b7 ^ (b9 &^ b8)
+	a[8] = b8 ^ (b5 &^ b9)
+	a[9] = b9 ^ (b6 &^ b5)
+	a[10] = b10 ^ (b12 &^ b11)
+	a[11] = b11 ^ (b13 &^ b12)
+	a[12] = b12 ^ (b14 &^ b13)
+	a[13] = b13 ^ (b10 &^ b14)
+	a[14] = b14 ^ (b11 &^ b10)
+	a[15] = b15 ^ (b17 &^ b16)
+	a[16] = b16 ^ (b18 &^ b17)
+	a[17] = b17 ^ (b19 &^ b18)
+	a[18] = b18 ^ (b15 &^ b19)
+	a[19] = b19 ^ (b16 &^ b15)
+	a[20] = b20 ^ (b22 &^ b21)
+	a[21] = b21 ^ (b23 &^ b22)
+	a[22] = b22 ^ (b24 &^ b23)
+	a[23] = b23 ^ (b20 &^ b24)
+	a[24] = b24 ^ (b21 &^ b20)
+}
+
+// keccakP1600 applies the last rounds rounds of the Keccak permutation to a
+// 1600b-wide state represented as a slice of 25 uint64s. With rounds set to
+// 24 this is Keccak-f[1600]; fewer rounds give the round-reduced
+// Keccak-p[1600, rounds] used by TurboSHAKE and KangarooTwelve.
+func keccakP1600(a *[25]uint64, rounds int) {
+	if rounds < 0 || rounds > 24 {
+		panic("keccak: unsupported number of rounds")
+	}
+
+	i := 24 - rounds
+	for ; (24-i)%4 != 0; i++ {
+		keccakRound(a, rc[i])
+	}
+
 	// Implementation translated from Keccak-inplace.c
 	// in the keccak reference code.
 	var t, bc0, bc1, bc2, bc3, bc4, d0, d1, d2, d3, d4 uint64
 
-	for i := 0; i < 24; i += 4 {
+	for ; i < 24; i += 4 {
 		// Combines the 5 steps in each round into 2 steps.
 		// Unrolls 4 rounds per loop and spreads some steps across rounds.
 
@@ -0,0 +1,20 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (386 && (!386.sse2 || purego || noasm || !gc)) || (arm && (purego || noasm || !gc)) || mips || mipsle
+
+package keccak
+
+// keccakF1600 applies the Keccak permutation to a 1600b-wide
+// state represented as a slice of 25 uint64s.
+//
+// On 32-bit platforms the bit-interleaved implementation is used, as
+// 64-bit rotations are expensive there.
+func keccakF1600(a *[25]uint64) {
+	if useXKCP {
+		keccakF1600XKCP(a)
+		return
+	}
+	keccakF1600Interleaved(a, 24)
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build 386 && 386.sse2 && !purego && !noasm && gc
+
+package keccak
+
+// useSSE2 reports whether to use the SSE2 implementations. With
+// GO386=sse2, the default, the Go runtime already requires SSE2, so there is
+// nothing to detect.
+var useSSE2 = backendAllowed("sse2")
+
+// keccakF1600 applies the Keccak permutation.
+func keccakF1600(a *[25]uint64) {
+	if useSSE2 {
+		keccakF1600SSE2(a)
+	} else {
+		keccakF1600Interleaved(a, 24)
+	}
+}
+
+// keccakF1600SSE2 is implemented in keccakf_sse2_386.s.
+//
+//go:noescape
+func keccakF1600SSE2(a *[25]uint64)
@@ -0,0 +1,41 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build 386 && 386.sse2 && !purego && !noasm && gc
+
+package keccak
+
+import "testing"
+
+func TestKeccakF1600SSE2MatchesGeneric(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i+1) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	for range 10 {
+		keccakF1600SSE2(&a)
+		keccakP1600(&b, 24)
+	}
+	if a != b {
+		t.Errorf("SSE2 permutation disagrees with the generic one")
+	}
+	for i, want := range keccakF1600Vectors {
+		a = [25]uint64{}
+		for range i + 1 {
+			keccakF1600SSE2(&a)
+		}
+		if a != want {
+			t.Errorf("SSE2 permutation applied %d times = %016X, want %016X", i+1, a, want)
+		}
+	}
+}
+
+func BenchmarkKeccakF1600SSE2(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600SSE2(&a)
+	}
+}
@@ -2,12 +2,42 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build amd64 && !purego && gc
+//go:build amd64 && !purego && !noasm && gc
 
 package keccak
 
-// This function is implemented in keccakf_amd64.s.
+// The implementations used, from the features of the CPU and the backends
+// allowed by GODEBUG.
+var (
+	useAVX512 = hasAVX512 && backendAllowed("avx512")
+	useAVX2   = hasAVX2 && backendAllowed("avx2")
+	useBMI2   = hasBMI && backendAllowed("bmi2")
+	useScalar = backendAllowed("scalar")
+)
+
+// keccakF1600 applies the Keccak permutation, using the AVX-512 or the
+// BMI2 implementation if the CPU supports them.
+func keccakF1600(a *[25]uint64) {
+	switch {
+	case useAVX512:
+		keccakF1600AVX512(a)
+	case useBMI2:
+		keccakF1600BMI2(a)
+	case useScalar:
+		keccakF1600Scalar(a)
+	default:
+		keccakP1600Complemented(a, 24)
+	}
+}
+
+// These functions are implemented in keccakf_amd64.s,
+// keccakf_bmi2_amd64.s and keccakf_avx512_amd64.s.
 
 //go:noescape
+func keccakF1600Scalar(a *[25]uint64)
 
-func keccakF1600(a *[25]uint64)
+//go:noescape
+func keccakF1600BMI2(a *[25]uint64)
+
+//go:noescape
+func keccakF1600AVX512(a *[25]uint64)
@@ -0,0 +1,79 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego && !noasm && gc
+
+package keccak
+
+import "testing"
+
+// amd64Permutations are the assembly implementations available on this CPU.
+func amd64Permutations() map[string]func(*[25]uint64) {
+	impls := map[string]func(*[25]uint64){"scalar": keccakF1600Scalar}
+	if hasBMI {
+		impls["BMI2"] = keccakF1600BMI2
+	}
+	if hasAVX512 {
+		impls["AVX512"] = keccakF1600AVX512
+	}
+	return impls
+}
+
+func TestKeccakF1600AssemblyMatchesGeneric(t *testing.T) {
+	for name, f := range amd64Permutations() {
+		var a, b [25]uint64
+		for i := range a {
+			a[i] = uint64(i+1) * 0x9e3779b97f4a7c15
+		}
+		b = a
+		// Chain several calls so that every lane goes through every
+		// position.
+		for range 10 {
+			f(&a)
+			keccakP1600(&b, 24)
+		}
+		if a != b {
+			t.Errorf("%s permutation disagrees with the generic one", name)
+		}
+		for i, want := range keccakF1600Vectors {
+			a = [25]uint64{}
+			for range i + 1 {
+				f(&a)
+			}
+			if a != want {
+				t.Errorf("%s permutation applied %d times = %016X, want %016X", name, i+1, a, want)
+			}
+		}
+	}
+}
+
+func BenchmarkKeccakF1600Scalar(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600Scalar(&a)
+	}
+}
+
+func BenchmarkKeccakF1600BMI2(b *testing.B) {
+	if !hasBMI {
+		b.Skip("BMI1 and BMI2 are not supported")
+	}
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600BMI2(&a)
+	}
+}
+
+func BenchmarkKeccakF1600AVX512(b *testing.B) {
+	if !hasAVX512 {
+		b.Skip("AVX-512 is not supported")
+	}
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600AVX512(&a)
+	}
+}
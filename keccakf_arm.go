@@ -0,0 +1,28 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm && !purego && !noasm && gc
+
+package keccak
+
+// useNEON reports whether to use the NEON implementation, which is
+// experimental.
+var useNEON = experimental && hasNEON && backendAllowed("neon")
+
+// keccakF1600 applies the Keccak permutation, with NEON if the CPU has it
+// and with the bit-interleaved implementation otherwise.
+func keccakF1600(a *[25]uint64) {
+	if useNEON {
+		keccakF1600NEON(a)
+	} else {
+		keccakF1600Interleaved(a, 24)
+	}
+}
+
+// keccakF1600NEON is implemented in keccakf_neon_arm.s. It keeps the state
+// in the 64-bit NEON registers, which shift whole lanes, so it does not
+// interleave them.
+//
+//go:noescape
+func keccakF1600NEON(a *[25]uint64)
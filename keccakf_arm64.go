@@ -0,0 +1,24 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !purego && !noasm && gc
+
+package keccak
+
+// keccakF1600 applies the Keccak permutation, using the Armv8.2 SHA-3
+// instructions if the CPU supports them and they are worth it.
+func keccakF1600(a *[25]uint64) {
+	if useSHA3 {
+		keccakF1600NEON(a)
+	} else {
+		keccakP1600(a, 24)
+	}
+}
+
+// keccakF1600NEON is implemented in keccakf_arm64.s. It keeps the state in
+// the NEON registers and uses EOR3, RAX1, XAR and BCAX from the SHA-3
+// extension.
+//
+//go:noescape
+func keccakF1600NEON(a *[25]uint64)
@@ -0,0 +1,54 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !purego && !noasm && gc
+
+package keccak
+
+import "testing"
+
+func TestKeccakF1600NEONMatchesGeneric(t *testing.T) {
+	if !useSHA3 {
+		t.Skip("the SHA-3 instructions are not used on this CPU")
+	}
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i+1) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	for range 10 {
+		keccakF1600NEON(&a)
+		keccakP1600(&b, 24)
+	}
+	if a != b {
+		t.Errorf("NEON permutation disagrees with the generic one")
+	}
+	for i, want := range keccakF1600Vectors {
+		a = [25]uint64{}
+		for range i + 1 {
+			keccakF1600NEON(&a)
+		}
+		if a != want {
+			t.Errorf("NEON permutation applied %d times = %016X, want %016X", i+1, a, want)
+		}
+	}
+}
+
+func TestKeccakF1600x2NEON(t *testing.T) {
+	if !useSHA3 {
+		t.Skip("the SHA-3 instructions are not used on this CPU")
+	}
+	testMultiLanes(t, "keccakF1600x2NEON", keccakF1600x2NEON)
+}
+
+func BenchmarkKeccakF1600NEON(b *testing.B) {
+	if !useSHA3 {
+		b.Skip("the SHA-3 instructions are not used on this CPU")
+	}
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600NEON(&a)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ((!amd64 && !arm64 && !riscv64 && !loong64) || purego || noasm || !gc) && !386 && !arm && !mips && !mipsle
+
+package keccak
+
+// keccakF1600 applies the Keccak permutation to a 1600b-wide
+// state represented as a slice of 25 uint64s.
+func keccakF1600(a *[25]uint64) {
+	if useXKCP {
+		keccakF1600XKCP(a)
+		return
+	}
+	keccakP1600Complemented(a, 24)
+}
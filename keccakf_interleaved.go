@@ -0,0 +1,267 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "math/bits"
+
+// This file implements Keccak-f[1600] on 32-bit words using the bit
+// interleaving technique from the Keccak implementation overview [1],
+// section 2.1. Each 64-bit lane is split into a word holding its even bits
+// and a word holding its odd bits, which turns every 64-bit rotation into
+// two 32-bit rotations. On 32-bit platforms this avoids the multi-
+// instruction sequences the compiler emits for 64-bit rotations.
+//
+// [1] https://keccak.team/files/Keccak-implementation-3.2.pdf
+
+// rcInterleaved holds the round constants in interleaved form, with the
+// even bits in the first word and the odd bits in the second.
+var rcInterleaved = func() (t [24][2]uint32) {
+	for i, c := range rc {
+		t[i][0], t[i][1] = interleave(c)
+	}
+	return
+}()
+
+// unshuffle32 moves the even bits of x to its lower half and the odd bits
+// to its upper half, preserving their order.
+func unshuffle32(x uint32) uint32 {
+	t := (x ^ (x >> 1)) & 0x22222222
+	x ^= t ^ (t << 1)
+	t = (x ^ (x >> 2)) & 0x0C0C0C0C
+	x ^= t ^ (t << 2)
+	t = (x ^ (x >> 4)) & 0x00F000F0
+	x ^= t ^ (t << 4)
+	t = (x ^ (x >> 8)) & 0x0000FF00
+	x ^= t ^ (t << 8)
+	return x
+}
+
+// shuffle32 is the inverse of unshuffle32.
+func shuffle32(x uint32) uint32 {
+	t := (x ^ (x >> 8)) & 0x0000FF00
+	x ^= t ^ (t << 8)
+	t = (x ^ (x >> 4)) & 0x00F000F0
+	x ^= t ^ (t << 4)
+	t = (x ^ (x >> 2)) & 0x0C0C0C0C
+	x ^= t ^ (t << 2)
+	t = (x ^ (x >> 1)) & 0x22222222
+	x ^= t ^ (t << 1)
+	return x
+}
+
+// interleave splits a lane into its even and odd bits.
+func interleave(lane uint64) (even, odd uint32) {
+	lo, hi := unshuffle32(uint32(lane)), unshuffle32(uint32(lane>>32))
+	even = lo&0xFFFF | hi<<16
+	odd = lo>>16 | hi&0xFFFF0000
+	return
+}
+
+// deinterleave is the inverse of interleave.
+func deinterleave(even, odd uint32) uint64 {
+	lo := shuffle32(even&0xFFFF | odd<<16)
+	hi := shuffle32(even>>16 | odd&0xFFFF0000)
+	return uint64(lo) | uint64(hi)<<32
+}
+
+// rotl64Interleaved rotates the interleaved lane (even, odd) left by n bits.
+func rotl64Interleaved(even, odd uint32, n int) (uint32, uint32) {
+	k := n / 2
+	if n%2 == 0 {
+		return bits.RotateLeft32(even, k), bits.RotateLeft32(odd, k)
+	}
+	// Even bits become odd bits, and odd bits become the even bits one
+	// position further along.
+	return bits.RotateLeft32(odd, k+1), bits.RotateLeft32(even, k)
+}
+
+// keccakF1600Interleaved applies the last rounds rounds of the Keccak
+// permutation to a, operating on the interleaved representation of the
+// state.
+func keccakF1600Interleaved(a *[25]uint64, rounds int) {
+	var ae, ao, be, bo [25]uint32
+	for i := range a {
+		ae[i], ao[i] = interleave(a[i])
+	}
+
+	// Rounds alternate between the two copies of the state, so that each
+	// round can read one while writing the other.
+	i := 24 - rounds
+	if rounds%2 == 1 {
+		roundInterleaved(&ae, &ao, &be, &bo, i)
+		ae, ao = be, bo
+		i++
+	}
+	for ; i < 24; i += 2 {
+		roundInterleaved(&ae, &ao, &be, &bo, i)
+		roundInterleaved(&be, &bo, &ae, &ao, i+1)
+	}
+
+	for i := range a {
+		a[i] = deinterleave(ae[i], ao[i])
+	}
+}
+
+// roundInterleaved applies round i of the Keccak permutation to the
+// interleaved state (ae, ao), writing the result to (be, bo). The θ, ρ, π,
+// χ and ι steps are combined, and the rotations are split into their even
+// and odd halves as described for rotl64Interleaved.
+func roundInterleaved(ae, ao, be, bo *[25]uint32, i int) {
+	var c0e, c0o, c1e, c1o, c2e, c2o, c3e, c3o, c4e, c4o uint32
+	var d0e, d0o, d1e, d1o, d2e, d2o, d3e, d3o, d4e, d4o uint32
+	var b0e, b0o, b1e, b1o, b2e, b2o, b3e, b3o, b4e, b4o uint32
+	var te, to uint32
+
+	c0e = ae[0] ^ ae[5] ^ ae[10] ^ ae[15] ^ ae[20]
+	c0o = ao[0] ^ ao[5] ^ ao[10] ^ ao[15] ^ ao[20]
+	c1e = ae[1] ^ ae[6] ^ ae[11] ^ ae[16] ^ ae[21]
+	c1o = ao[1] ^ ao[6] ^ ao[11] ^ ao[16] ^ ao[21]
+	c2e = ae[2] ^ ae[7] ^ ae[12] ^ ae[17] ^ ae[22]
+	c2o = ao[2] ^ ao[7] ^ ao[12] ^ ao[17] ^ ao[22]
+	c3e = ae[3] ^ ae[8] ^ ae[13] ^ ae[18] ^ ae[23]
+	c3o = ao[3] ^ ao[8] ^ ao[13] ^ ao[18] ^ ao[23]
+	c4e = ae[4] ^ ae[9] ^ ae[14] ^ ae[19] ^ ae[24]
+	c4o = ao[4] ^ ao[9] ^ ao[14] ^ ao[19] ^ ao[24]
+	d0e = c4e ^ bits.RotateLeft32(c1o, 1)
+	d0o = c4o ^ c1e
+	d1e = c0e ^ bits.RotateLeft32(c2o, 1)
+	d1o = c0o ^ c2e
+	d2e = c1e ^ bits.RotateLeft32(c3o, 1)
+	d2o = c1o ^ c3e
+	d3e = c2e ^ bits.RotateLeft32(c4o, 1)
+	d3o = c2o ^ c4e
+	d4e = c3e ^ bits.RotateLeft32(c0o, 1)
+	d4o = c3o ^ c0e
+
+	te = ae[0] ^ d0e
+	to = ao[0] ^ d0o
+	b0e, b0o = te, to
+	te = ae[6] ^ d1e
+	to = ao[6] ^ d1o
+	b1e, b1o = bits.RotateLeft32(te, 22), bits.RotateLeft32(to, 22)
+	te = ae[12] ^ d2e
+	to = ao[12] ^ d2o
+	b2e, b2o = bits.RotateLeft32(to, 22), bits.RotateLeft32(te, 21)
+	te = ae[18] ^ d3e
+	to = ao[18] ^ d3o
+	b3e, b3o = bits.RotateLeft32(to, 11), bits.RotateLeft32(te, 10)
+	te = ae[24] ^ d4e
+	to = ao[24] ^ d4o
+	b4e, b4o = bits.RotateLeft32(te, 7), bits.RotateLeft32(to, 7)
+	be[0] = b0e ^ (b2e &^ b1e) ^ rcInterleaved[i][0]
+	bo[0] = b0o ^ (b2o &^ b1o) ^ rcInterleaved[i][1]
+	be[1] = b1e ^ (b3e &^ b2e)
+	bo[1] = b1o ^ (b3o &^ b2o)
+	be[2] = b2e ^ (b4e &^ b3e)
+	bo[2] = b2o ^ (b4o &^ b3o)
+	be[3] = b3e ^ (b0e &^ b4e)
+	bo[3] = b3o ^ (b0o &^ b4o)
+	be[4] = b4e ^ (b1e &^ b0e)
+	bo[4] = b4o ^ (b1o &^ b0o)
+
+	te = ae[3] ^ d3e
+	to = ao[3] ^ d3o
+	b0e, b0o = bits.RotateLeft32(te, 14), bits.RotateLeft32(to, 14)
+	te = ae[9] ^ d4e
+	to = ao[9] ^ d4o
+	b1e, b1o = bits.RotateLeft32(te, 10), bits.RotateLeft32(to, 10)
+	te = ae[10] ^ d0e
+	to = ao[10] ^ d0o
+	b2e, b2o = bits.RotateLeft32(to, 2), bits.RotateLeft32(te, 1)
+	te = ae[16] ^ d1e
+	to = ao[16] ^ d1o
+	b3e, b3o = bits.RotateLeft32(to, 23), bits.RotateLeft32(te, 22)
+	te = ae[22] ^ d2e
+	to = ao[22] ^ d2o
+	b4e, b4o = bits.RotateLeft32(to, 31), bits.RotateLeft32(te, 30)
+	be[5] = b0e ^ (b2e &^ b1e)
+	bo[5] = b0o ^ (b2o &^ b1o)
+	be[6] = b1e ^ (b3e &^ b2e)
+	bo[6] = b1o ^ (b3o &^ b2o)
+	be[7] = b2e ^ (b4e &^ b3e)
+	bo[7] = b2o ^ (b4o &^ b3o)
+	be[8] = b3e ^ (b0e &^ b4e)
+	bo[8] = b3o ^ (b0o &^ b4o)
+	be[9] = b4e ^ (b1e &^ b0e)
+	bo[9] = b4o ^ (b1o &^ b0o)
+
+	te = ae[1] ^ d1e
+	to = ao[1] ^ d1o
+	b0e, b0o = bits.RotateLeft32(to, 1), te
+	te = ae[7] ^ d2e
+	to = ao[7] ^ d2o
+	b1e, b1o = bits.RotateLeft32(te, 3), bits.RotateLeft32(to, 3)
+	te = ae[13] ^ d3e
+	to = ao[13] ^ d3o
+	b2e, b2o = bits.RotateLeft32(to, 13), bits.RotateLeft32(te, 12)
+	te = ae[19] ^ d4e
+	to = ao[19] ^ d4o
+	b3e, b3o = bits.RotateLeft32(te, 4), bits.RotateLeft32(to, 4)
+	te = ae[20] ^ d0e
+	to = ao[20] ^ d0o
+	b4e, b4o = bits.RotateLeft32(te, 9), bits.RotateLeft32(to, 9)
+	be[10] = b0e ^ (b2e &^ b1e)
+	bo[10] = b0o ^ (b2o &^ b1o)
+	be[11] = b1e ^ (b3e &^ b2e)
+	bo[11] = b1o ^ (b3o &^ b2o)
+	be[12] = b2e ^ (b4e &^ b3e)
+	bo[12] = b2o ^ (b4o &^ b3o)
+	be[13] = b3e ^ (b0e &^ b4e)
+	bo[13] = b3o ^ (b0o &^ b4o)
+	be[14] = b4e ^ (b1e &^ b0e)
+	bo[14] = b4o ^ (b1o &^ b0o)
+
+	te = ae[4] ^ d4e
+	to = ao[4] ^ d4o
+	b0e, b0o = bits.RotateLeft32(to, 14), bits.RotateLeft32(te, 13)
+	te = ae[5] ^ d0e
+	to = ao[5] ^ d0o
+	b1e, b1o = bits.RotateLeft32(te, 18), bits.RotateLeft32(to, 18)
+	te = ae[11] ^ d1e
+	to = ao[11] ^ d1o
+	b2e, b2o = bits.RotateLeft32(te, 5), bits.RotateLeft32(to, 5)
+	te = ae[17] ^ d2e
+	to = ao[17] ^ d2o
+	b3e, b3o = bits.RotateLeft32(to, 8), bits.RotateLeft32(te, 7)
+	te = ae[23] ^ d3e
+	to = ao[23] ^ d3o
+	b4e, b4o = bits.RotateLeft32(te, 28), bits.RotateLeft32(to, 28)
+	be[15] = b0e ^ (b2e &^ b1e)
+	bo[15] = b0o ^ (b2o &^ b1o)
+	be[16] = b1e ^ (b3e &^ b2e)
+	bo[16] = b1o ^ (b3o &^ b2o)
+	be[17] = b2e ^ (b4e &^ b3e)
+	bo[17] = b2o ^ (b4o &^ b3o)
+	be[18] = b3e ^ (b0e &^ b4e)
+	bo[18] = b3o ^ (b0o &^ b4o)
+	be[19] = b4e ^ (b1e &^ b0e)
+	bo[19] = b4o ^ (b1o &^ b0o)
+
+	te = ae[2] ^ d2e
+	to = ao[2] ^ d2o
+	b0e, b0o = bits.RotateLeft32(te, 31), bits.RotateLeft32(to, 31)
+	te = ae[8] ^ d3e
+	to = ao[8] ^ d3o
+	b1e, b1o = bits.RotateLeft32(to, 28), bits.RotateLeft32(te, 27)
+	te = ae[14] ^ d4e
+	to = ao[14] ^ d4o
+	b2e, b2o = bits.RotateLeft32(to, 20), bits.RotateLeft32(te, 19)
+	te = ae[15] ^ d0e
+	to = ao[15] ^ d0o
+	b3e, b3o = bits.RotateLeft32(to, 21), bits.RotateLeft32(te, 20)
+	te = ae[21] ^ d1e
+	to = ao[21] ^ d1o
+	b4e, b4o = bits.RotateLeft32(te, 1), bits.RotateLeft32(to, 1)
+	be[20] = b0e ^ (b2e &^ b1e)
+	bo[20] = b0o ^ (b2o &^ b1o)
+	be[21] = b1e ^ (b3e &^ b2e)
+	bo[21] = b1o ^ (b3o &^ b2o)
+	be[22] = b2e ^ (b4e &^ b3e)
+	bo[22] = b2o ^ (b4o &^ b3o)
+	be[23] = b3e ^ (b0e &^ b4e)
+	bo[23] = b3o ^ (b0o &^ b4o)
+	be[24] = b4e ^ (b1e &^ b0e)
+	bo[24] = b4o ^ (b1o &^ b0o)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build loong64 && !purego && !noasm && gc
+
+package keccak
+
+// useScalar reports whether to use the scalar assembly implementation,
+// which is experimental and needs nothing beyond the base instruction set.
+var useScalar = experimental && backendAllowed("scalar")
+
+// keccakF1600 applies the Keccak permutation.
+func keccakF1600(a *[25]uint64) {
+	switch {
+	case useScalar:
+		keccakF1600Scalar(a)
+	case useXKCP:
+		keccakF1600XKCP(a)
+	default:
+		keccakP1600Complemented(a, 24)
+	}
+}
+
+// keccakF1600Scalar is implemented in keccakf_scalar_loong64.s. It keeps the
+// state in the general-purpose registers, rotates the lanes with ROTRV and
+// computes χ with ANDN.
+//
+//go:noescape
+func keccakF1600Scalar(a *[25]uint64)
@@ -0,0 +1,63 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build loong64 && !purego && !noasm && gc
+
+package keccak
+
+import "testing"
+
+// TestKeccakF1600ScalarMatchesGeneric is the golden test of the scalar
+// permutation: it is compared with the generic one, and with the vectors.
+func TestKeccakF1600ScalarMatchesGeneric(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i+1) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	for range 10 {
+		keccakF1600Scalar(&a)
+		keccakP1600(&b, 24)
+	}
+	if a != b {
+		t.Errorf("scalar permutation disagrees with the generic one")
+	}
+	for i, want := range keccakF1600Vectors {
+		a = [25]uint64{}
+		for range i + 1 {
+			keccakF1600Scalar(&a)
+		}
+		if a != want {
+			t.Errorf("scalar permutation applied %d times = %016X, want %016X", i+1, a, want)
+		}
+	}
+}
+
+func TestKeccakF1600x2LSX(t *testing.T) {
+	if !hasLSX {
+		t.Skip("LSX is not supported")
+	}
+	testMultiLanes(t, "keccakF1600x2LSX", keccakF1600x2LSX)
+}
+
+func BenchmarkKeccakF1600Scalar(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600Scalar(&a)
+	}
+}
+
+// BenchmarkKeccakF1600x2LSX permutes two states, to be compared with twice
+// BenchmarkKeccakF1600.
+func BenchmarkKeccakF1600x2LSX(b *testing.B) {
+	if !hasLSX {
+		b.Skip("LSX is not supported")
+	}
+	var a [25][2]uint64
+	b.SetBytes(2 * 200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600x2LSX(&a)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"cmp"
+	"encoding/binary"
+	"unsafe"
+)
+
+// narrowLane is the lane type of the Keccak-f[800], Keccak-f[400] and
+// Keccak-f[200] permutations.
+type narrowLane interface {
+	uint32 | uint16 | uint8
+}
+
+// Number of rounds of the narrow Keccak-f permutations, 12+2l for lanes of
+// 2^l bits.
+const (
+	roundsF800 = 22
+	roundsF400 = 20
+	roundsF200 = 18
+)
+
+// keccakFNarrow applies the last rounds rounds of Keccak-f[25w] to a, where
+// w is the lane size in bits and total is the number of rounds of the full
+// permutation. The round constants are those of Keccak-f[1600] truncated to
+// the lane size, and the rotation offsets are taken modulo the lane size.
+//
+// The narrow permutations are not used by any of the standard hash
+// functions, so this is a direct transcription of the step mappings rather
+// than an optimized implementation.
+func keccakFNarrow[T narrowLane](a *[25]T, rounds, total int) {
+	w := int(unsafe.Sizeof(T(0))) * 8
+	rotl := func(v T, n int) T {
+		n %= w
+		return v<<n | v>>(w-n)
+	}
+
+	for i := total - rounds; i < total; i++ {
+		// θ step
+		var bc [5]T
+		for x := range bc {
+			bc[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d := bc[(x+4)%5] ^ rotl(bc[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				a[y+x] ^= d
+			}
+		}
+
+		// ρ and π steps
+		var b [25]T
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = rotl(a[x+5*y], rho[x+5*y])
+			}
+		}
+
+		// χ step
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (b[y+(x+2)%5] &^ b[y+(x+1)%5])
+			}
+		}
+
+		// ι step
+		a[0] ^= T(rc[i])
+	}
+}
+
+// permuteNarrow applies the narrow permutation selected by d.width to the
+// first d.width bytes of the state.
+func (d *state) permuteNarrow() {
+	switch d.width {
+	case 100:
+		var a [25]uint32
+		for i := range a {
+			a[i] = binary.LittleEndian.Uint32(d.a[i*4:])
+		}
+		keccakFNarrow(&a, cmp.Or(d.rounds, roundsF800), roundsF800)
+		for i := range a {
+			binary.LittleEndian.PutUint32(d.a[i*4:], a[i])
+		}
+	case 50:
+		var a [25]uint16
+		for i := range a {
+			a[i] = binary.LittleEndian.Uint16(d.a[i*2:])
+		}
+		keccakFNarrow(&a, cmp.Or(d.rounds, roundsF400), roundsF400)
+		for i := range a {
+			binary.LittleEndian.PutUint16(d.a[i*2:], a[i])
+		}
+	case 25:
+		a := (*[25]uint8)(d.a[:25])
+		keccakFNarrow(a, cmp.Or(d.rounds, roundsF200), roundsF200)
+	default:
+		panic("keccak: invalid permutation width")
+	}
+	d.n = 0
+}
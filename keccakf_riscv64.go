@@ -0,0 +1,31 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build riscv64 && !purego && !noasm && gc
+
+package keccak
+
+// useZbb reports whether to use the scalar implementation with the rotate
+// and AND-NOT instructions of Zbb, which is experimental.
+var useZbb = experimental && hasZbb && backendAllowed("zbb")
+
+// keccakF1600 applies the Keccak permutation, using the Zbb instructions if
+// the CPU supports them.
+func keccakF1600(a *[25]uint64) {
+	switch {
+	case useZbb:
+		keccakF1600Zbb(a)
+	case useXKCP:
+		keccakF1600XKCP(a)
+	default:
+		keccakP1600Complemented(a, 24)
+	}
+}
+
+// keccakF1600Zbb is implemented in keccakf_zbb_riscv64.s. It keeps the state
+// in the general-purpose registers, rotates the lanes with RORI and computes
+// χ with ANDN.
+//
+//go:noescape
+func keccakF1600Zbb(a *[25]uint64)
@@ -0,0 +1,72 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build riscv64 && !purego && !noasm && gc
+
+package keccak
+
+import "testing"
+
+func TestKeccakF1600ZbbMatchesGeneric(t *testing.T) {
+	if !hasZbb {
+		t.Skip("the Zbb extension is not supported")
+	}
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i+1) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	for range 10 {
+		keccakF1600Zbb(&a)
+		keccakP1600(&b, 24)
+	}
+	if a != b {
+		t.Errorf("Zbb permutation disagrees with the generic one")
+	}
+	for i, want := range keccakF1600Vectors {
+		a = [25]uint64{}
+		for range i + 1 {
+			keccakF1600Zbb(&a)
+		}
+		if a != want {
+			t.Errorf("Zbb permutation applied %d times = %016X, want %016X", i+1, a, want)
+		}
+	}
+}
+
+func TestKeccakF1600RVV(t *testing.T) {
+	if !hasRVV {
+		t.Skip("the vector extension is not supported")
+	}
+	// Depending on the vector length, groups of states that leave part of
+	// a vector register unused, that fill it, and that take several.
+	testMultiLanes(t, "keccakF1600RVV/2", func(a *[25][2]uint64) { keccakF1600RVV(&a[0][0], 2) })
+	testMultiLanes(t, "keccakF1600RVV/4", func(a *[25][4]uint64) { keccakF1600RVV(&a[0][0], 4) })
+	testMultiLanes(t, "keccakF1600RVV/8", func(a *[25][8]uint64) { keccakF1600RVV(&a[0][0], 8) })
+}
+
+func BenchmarkKeccakF1600Zbb(b *testing.B) {
+	if !hasZbb {
+		b.Skip("the Zbb extension is not supported")
+	}
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600Zbb(&a)
+	}
+}
+
+// BenchmarkKeccakF1600RVV permutes as many states as a vector register
+// holds, to be compared with as many times BenchmarkKeccakF1600.
+func BenchmarkKeccakF1600RVV(b *testing.B) {
+	if !hasRVV {
+		b.Skip("the vector extension is not supported")
+	}
+	n := rvvLanes()
+	a := make([]uint64, 25*n)
+	b.SetBytes(int64(200 * n))
+	for i := 0; i < b.N; i++ {
+		keccakF1600RVV(&a[0], n)
+	}
+}
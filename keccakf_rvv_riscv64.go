@@ -0,0 +1,66 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build riscv64 && !purego && !noasm && gc
+
+package keccak
+
+// useRVV reports whether to use the vector implementation for groups of
+// states, which is experimental, and rvvStates is how many states it permutes at once: as many as
+// a vector register has 64-bit elements.
+var (
+	useRVV    = experimental && hasRVV && backendAllowed("rvv")
+	rvvStates = func() int {
+		if !useRVV {
+			return 0
+		}
+		return rvvLanes()
+	}()
+)
+
+// The multi-buffer permutations use the vector code when a vector register
+// holds all their states, so that it permutes them together.
+var (
+	fastX2 = rvvStates >= 2
+	fastX4 = rvvStates >= 4
+	fastX8 = rvvStates >= 8
+)
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states.
+func keccakF1600x2(a *[25][2]uint64) {
+	if fastX2 {
+		keccakF1600RVV(&a[0][0], 2)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x4 applies Keccak-f[1600] to four interleaved states.
+func keccakF1600x4(a *[25][4]uint64) {
+	if fastX4 {
+		keccakF1600RVV(&a[0][0], 4)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x8 applies Keccak-f[1600] to eight interleaved states.
+func keccakF1600x8(a *[25][8]uint64) {
+	if fastX8 {
+		keccakF1600RVV(&a[0][0], 8)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600RVV is implemented in keccakf_rvv_riscv64.s. It applies
+// Keccak-f[1600] to the n interleaved states at a, lane i of state j being
+// at a[i*n+j], as many at a time as a vector register holds.
+//
+//go:noescape
+func keccakF1600RVV(a *uint64, n int)
+
+// rvvLanes returns the number of 64-bit elements of a vector register. It
+// is implemented in keccakf_rvv_riscv64.s.
+func rvvLanes() int
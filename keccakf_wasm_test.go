@@ -0,0 +1,23 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build wasm && keccak_simd128 && !purego && !noasm && gc
+
+package keccak
+
+import "testing"
+
+func TestKeccakF1600x2SIMD128(t *testing.T) {
+	testMultiLanes(t, "keccakF1600x2SIMD128", keccakF1600x2SIMD128)
+}
+
+// BenchmarkKeccakF1600x2SIMD128 permutes two states, to be compared with
+// twice BenchmarkKeccakF1600.
+func BenchmarkKeccakF1600x2SIMD128(b *testing.B) {
+	var a [25][2]uint64
+	b.SetBytes(2 * 200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600x2SIMD128(&a)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build 386 && 386.sse2 && !purego && !noasm && gc
+
+package keccak
+
+// fastX2 reports whether keccakF1600x2 is faster than two calls to
+// keccakF1600.
+var fastX2 = useSSE2
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states.
+func keccakF1600x2(a *[25][2]uint64) {
+	if useSSE2 {
+		keccakF1600x2SSE2(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x2SSE2 is implemented in keccakf_x2_sse2_386.s. Each lane of
+// both states fills one SSE2 register.
+//
+//go:noescape
+func keccakF1600x2SSE2(a *[25][2]uint64)
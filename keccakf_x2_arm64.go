@@ -0,0 +1,28 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !purego && !noasm && gc
+
+package keccak
+
+// fastX2 reports whether keccakF1600x2 is faster than two calls to
+// keccakF1600.
+var fastX2 = useSHA3
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states, using the
+// Armv8.2 SHA-3 instructions where keccakF1600 does.
+func keccakF1600x2(a *[25][2]uint64) {
+	if useSHA3 {
+		keccakF1600x2NEON(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x2NEON is implemented in keccakf_x2_arm64.s. It is the code of
+// keccakF1600NEON, whose instructions all work on both halves of the NEON
+// registers, with the lanes of the two states loaded in the two halves.
+//
+//go:noescape
+func keccakF1600x2NEON(a *[25][2]uint64)
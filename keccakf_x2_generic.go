@@ -0,0 +1,18 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ((!386 || !386.sse2) && !arm64 && !ppc64le && !riscv64 && !loong64 && (!wasm || !keccak_simd128)) || purego || noasm || !gc
+
+package keccak
+
+// fastX2 reports whether keccakF1600x2 is faster than two calls to
+// keccakF1600.
+const fastX2 = false
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states. On amd64,
+// running the scalar assembly on each is faster than SSE2, which has no
+// vector rotate.
+func keccakF1600x2(a *[25][2]uint64) {
+	keccakF1600Lanes(a)
+}
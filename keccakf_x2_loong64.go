@@ -0,0 +1,30 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build loong64 && !purego && !noasm && gc
+
+package keccak
+
+// useLSX reports whether to use the LSX implementation, which is
+// experimental.
+var useLSX = experimental && hasLSX && backendAllowed("lsx")
+
+// fastX2 reports whether keccakF1600x2 is faster than two calls to
+// keccakF1600.
+var fastX2 = useLSX
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states.
+func keccakF1600x2(a *[25][2]uint64) {
+	if useLSX {
+		keccakF1600x2LSX(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x2LSX is implemented in keccakf_x2_lsx_loong64.s. Each lane of
+// both states fills one vector register.
+//
+//go:noescape
+func keccakF1600x2LSX(a *[25][2]uint64)
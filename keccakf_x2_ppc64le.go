@@ -0,0 +1,31 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ppc64le && !purego && !noasm && gc
+
+package keccak
+
+// useVSX reports whether to use the VSX implementation, which is
+// experimental. POWER8, the oldest CPU Go supports on ppc64le, has every
+// instruction it needs.
+var useVSX = experimental && backendAllowed("vsx")
+
+// fastX2 reports whether keccakF1600x2 is faster than two calls to
+// keccakF1600.
+var fastX2 = useVSX
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states.
+func keccakF1600x2(a *[25][2]uint64) {
+	if useVSX {
+		keccakF1600x2VSX(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x2VSX is implemented in keccakf_x2_vsx_ppc64le.s. Each lane of
+// both states fills one vector register.
+//
+//go:noescape
+func keccakF1600x2VSX(a *[25][2]uint64)
@@ -0,0 +1,31 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build wasm && keccak_simd128 && !purego && !noasm && gc
+
+package keccak
+
+// useSIMD128 reports whether to use the SIMD128 implementation. A module
+// that contains it does not load in an engine without SIMD128, so there is
+// nothing to detect: it is only built with the keccak_simd128 tag.
+var useSIMD128 = backendAllowed("simd128")
+
+// fastX2 reports whether keccakF1600x2 is faster than two calls to
+// keccakF1600.
+var fastX2 = useSIMD128
+
+// keccakF1600x2 applies Keccak-f[1600] to two interleaved states.
+func keccakF1600x2(a *[25][2]uint64) {
+	if useSIMD128 {
+		keccakF1600x2SIMD128(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x2SIMD128 is implemented in keccakf_x2_simd128_wasm.s. Each
+// 16-byte lane of a holds the same lane of both states.
+//
+//go:noescape
+func keccakF1600x2SIMD128(a *[25][2]uint64)
@@ -0,0 +1,26 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego && !noasm && gc
+
+package keccak
+
+// fastX4 reports whether keccakF1600x4 is faster than four calls to
+// keccakF1600.
+var fastX4 = useAVX2
+
+// keccakF1600x4 applies Keccak-f[1600] to four interleaved states, using
+// AVX2 if the CPU supports it.
+func keccakF1600x4(a *[25][4]uint64) {
+	if useAVX2 {
+		keccakF1600x4AVX2(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x4AVX2 is implemented in keccakf_x4_avx2_amd64.s.
+//
+//go:noescape
+func keccakF1600x4AVX2(a *[25][4]uint64)
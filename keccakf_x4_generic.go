@@ -0,0 +1,16 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (!amd64 && !riscv64) || purego || noasm || !gc
+
+package keccak
+
+// fastX4 reports whether keccakF1600x4 is faster than four calls to
+// keccakF1600.
+const fastX4 = false
+
+// keccakF1600x4 applies Keccak-f[1600] to four interleaved states.
+func keccakF1600x4(a *[25][4]uint64) {
+	keccakF1600Lanes(a)
+}
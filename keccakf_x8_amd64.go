@@ -0,0 +1,26 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego && !noasm && gc
+
+package keccak
+
+// fastX8 reports whether keccakF1600x8 is faster than eight calls to
+// keccakF1600.
+var fastX8 = useAVX512
+
+// keccakF1600x8 applies Keccak-f[1600] to eight interleaved states, using
+// AVX-512 if the CPU supports it.
+func keccakF1600x8(a *[25][8]uint64) {
+	if useAVX512 {
+		keccakF1600x8AVX512(a)
+	} else {
+		keccakF1600Lanes(a)
+	}
+}
+
+// keccakF1600x8AVX512 is implemented in keccakf_x8_avx512_amd64.s.
+//
+//go:noescape
+func keccakF1600x8AVX512(a *[25][8]uint64)
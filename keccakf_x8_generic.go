@@ -0,0 +1,16 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (!amd64 && !riscv64) || purego || noasm || !gc
+
+package keccak
+
+// fastX8 reports whether keccakF1600x8 is faster than eight calls to
+// keccakF1600.
+const fastX8 = false
+
+// keccakF1600x8 applies Keccak-f[1600] to eight interleaved states.
+func keccakF1600x8(a *[25][8]uint64) {
+	keccakF1600Lanes(a)
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file implements the Ketje v2 authenticated encryption family, the
+// MonkeyWrap mode over round-reduced Keccak-p permutations [1].
+//
+// [1] https://keccak.team/files/Ketjev2-doc2.0.pdf
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+)
+
+// Round counts of the MonkeyDuplex calls used by Ketje.
+const (
+	ketjeStartRounds  = 12
+	ketjeStepRounds   = 1
+	ketjeStrideRounds = 6
+)
+
+// Frame bits of the MonkeyWrap blocks, followed by the first bit of the
+// padding. The last bit of the padding is ketjePadEnd, in the byte just
+// after the block.
+const (
+	ketjeFrame0  = 0x02 // the single bit 0, used to extend the tag
+	ketjeFrame00 = 0x04 // associated data, more blocks follow
+	ketjeFrame01 = 0x06 // last block of associated data
+	ketjeFrame11 = 0x07 // message, more blocks follow
+	ketjeFrame10 = 0x05 // last block of message
+	ketjePadEnd  = 0x08
+)
+
+// ketjeTagSize is the tag size of the cipher.AEAD wrappers, 128 bits.
+const ketjeTagSize = 16
+
+// ketjeTwist maps the lanes of the input to the lanes of the state. Ketje
+// v2 uses the twisted permutation π ∘ Keccak-p ∘ π⁻¹, which is applied by
+// permuting the state as usual and storing input lane (x, y) at
+// π⁻¹(x, y) = (x+3y, x).
+var ketjeTwist = [25]byte{
+	0, 6, 12, 18, 24,
+	3, 9, 10, 16, 22,
+	1, 7, 13, 19, 20,
+	4, 5, 11, 17, 23,
+	2, 8, 14, 15, 21,
+}
+
+// monkeyDuplex is the MonkeyDuplex construction over the twisted
+// Keccak-p permutation of s.width bytes.
+type monkeyDuplex struct {
+	s    state
+	lane int // lane size in bytes
+	rho  int // block size in bytes
+}
+
+// index returns the position in d.s.a of byte i of the twisted state.
+func (d *monkeyDuplex) index(i int) int {
+	return int(ketjeTwist[i/d.lane])*d.lane + i%d.lane
+}
+
+func (d *monkeyDuplex) xorIn(in []byte) {
+	for i, b := range in {
+		d.s.a[d.index(i)] ^= b
+	}
+}
+
+func (d *monkeyDuplex) permute(rounds int) {
+	d.s.rounds = rounds
+	d.s.permute()
+}
+
+// start absorbs the initial state I, padded to the full width.
+func (d *monkeyDuplex) start(in []byte) {
+	width := d.lane * 25
+	d.xorIn(in)
+	d.s.a[d.index(len(in))] ^= 0x01
+	d.s.a[d.index(width-1)] ^= 0x80
+	d.permute(ketjeStartRounds)
+}
+
+// step absorbs a block of at most rho bytes followed by the frame bits,
+// applies rounds rounds and writes up to rho bytes of output to out.
+func (d *monkeyDuplex) step(in []byte, frame byte, out []byte, rounds int) {
+	d.xorIn(in)
+	d.s.a[d.index(len(in))] ^= frame
+	d.s.a[d.index(d.rho)] ^= ketjePadEnd
+	d.permute(rounds)
+	for i := range out {
+		out[i] = d.s.a[d.index(i)]
+	}
+}
+
+type ketje struct {
+	width     int // permutation width in bytes
+	rho       int // block size in bytes
+	key       []byte
+	nonceSize int
+}
+
+func newKetje(key []byte, width, rho, minKeySize, nonceSize int) (cipher.AEAD, error) {
+	// The key pack adds two bytes to the key, and the start input must
+	// leave room for at least one byte of padding.
+	maxKeySize := width - 3 - nonceSize
+	if len(key) < minKeySize || len(key) > maxKeySize {
+		return nil, errors.New("keccak: invalid Ketje key size")
+	}
+	return &ketje{
+		width:     width,
+		rho:       rho,
+		key:       append([]byte(nil), key...),
+		nonceSize: nonceSize,
+	}, nil
+}
+
+// NewKetjeJr returns a cipher.AEAD implementing Ketje Jr, over
+// Keccak-p[200]. The key must be 12 bytes long, and the nonce is 10 bytes.
+func NewKetjeJr(key []byte) (cipher.AEAD, error) {
+	return newKetje(key, 25, 2, 12, 10)
+}
+
+// NewKetjeSr returns a cipher.AEAD implementing Ketje Sr, over
+// Keccak-p[400]. The key must be between 16 and 31 bytes long, and the
+// nonce is 16 bytes.
+func NewKetjeSr(key []byte) (cipher.AEAD, error) {
+	return newKetje(key, 50, 4, 16, 16)
+}
+
+// NewKetjeMinor returns a cipher.AEAD implementing Ketje Minor, over
+// Keccak-p[800]. The key must be between 16 and 81 bytes long, and the
+// nonce is 16 bytes.
+func NewKetjeMinor(key []byte) (cipher.AEAD, error) {
+	return newKetje(key, 100, 16, 16, 16)
+}
+
+// NewKetjeMajor returns a cipher.AEAD implementing Ketje Major, over
+// Keccak-p[1600]. The key must be between 16 and 181 bytes long, and the
+// nonce is 16 bytes.
+func NewKetjeMajor(key []byte) (cipher.AEAD, error) {
+	return newKetje(key, 200, 32, 16, 16)
+}
+
+func (k *ketje) NonceSize() int { return k.nonceSize }
+
+func (k *ketje) Overhead() int { return ketjeTagSize }
+
+// init returns a MonkeyDuplex object started with the key and nonce that
+// has absorbed the associated data, and writes the keystream for the first
+// block of the message to z.
+func (k *ketje) init(nonce, additionalData, z []byte) *monkeyDuplex {
+	d := &monkeyDuplex{lane: k.width / 25, rho: k.rho}
+	if k.width != 200 {
+		d.s.width = k.width
+	}
+
+	// The key pack is the length of the key pack in bytes, the key and
+	// a one bit of padding.
+	in := make([]byte, 0, len(k.key)+2+len(nonce))
+	in = append(in, byte(len(k.key)+2))
+	in = append(in, k.key...)
+	in = append(in, 0x01)
+	in = append(in, nonce...)
+	d.start(in)
+
+	for len(additionalData) > k.rho {
+		d.step(additionalData[:k.rho], ketjeFrame00, nil, ketjeStepRounds)
+		additionalData = additionalData[k.rho:]
+	}
+	d.step(additionalData, ketjeFrame01, z, ketjeStepRounds)
+	return d
+}
+
+// crypt processes the message blocks, XORing in into out with the
+// keystream in z and absorbing the plaintext, which is in when encrypting
+// and out when decrypting. It then writes the tag to tag.
+func (k *ketje) crypt(d *monkeyDuplex, out, in, z, tag []byte, decrypt bool) {
+	// When encrypting in place, each plaintext block is overwritten before
+	// it is absorbed, so it is saved first.
+	block := make([]byte, k.rho)
+	for {
+		n := min(len(in), k.rho)
+		plaintext := out[:n]
+		if !decrypt {
+			plaintext = block[:n]
+			copy(plaintext, in[:n])
+		}
+		subtle.XORBytes(out[:n], in[:n], z[:n])
+		in, out = in[n:], out[n:]
+		if len(in) == 0 {
+			d.step(plaintext, ketjeFrame10, z, ketjeStrideRounds)
+			break
+		}
+		d.step(plaintext, ketjeFrame11, z[:min(len(in), k.rho)], ketjeStepRounds)
+	}
+
+	for {
+		tag = tag[copy(tag, z):]
+		if len(tag) == 0 {
+			return
+		}
+		d.step(nil, ketjeFrame0, z, ketjeStepRounds)
+	}
+}
+
+func (k *ketje) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	if len(nonce) != k.nonceSize {
+		panic("keccak: incorrect nonce length given to Ketje")
+	}
+
+	ret, out := sliceForAppend(dst, len(plaintext)+ketjeTagSize)
+	if inexactOverlap(out, plaintext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	z := make([]byte, k.rho)
+	d := k.init(nonce, additionalData, z[:min(len(plaintext), k.rho)])
+	k.crypt(d, out[:len(plaintext)], plaintext, z, out[len(plaintext):], false)
+	return ret
+}
+
+func (k *ketje) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != k.nonceSize {
+		panic("keccak: incorrect nonce length given to Ketje")
+	}
+	if len(ciphertext) < ketjeTagSize {
+		return nil, errOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-ketjeTagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-ketjeTagSize]
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if inexactOverlap(out, ciphertext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	z := make([]byte, k.rho)
+	d := k.init(nonce, additionalData, z[:min(len(ciphertext), k.rho)])
+	var expected [ketjeTagSize]byte
+	k.crypt(d, out, ciphertext, z, expected[:], true)
+
+	if subtle.ConstantTimeCompare(expected[:], tag) != 1 {
+		clear(out)
+		return nil, errOpen
+	}
+	return ret, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/hex"
+	"testing"
+)
+
+var ketjeInstances = []struct {
+	name      string
+	new       func(key []byte) (cipher.AEAD, error)
+	keySize   int
+	nonceSize int
+}{
+	{"KetjeJr", NewKetjeJr, 12, 10},
+	{"KetjeSr", NewKetjeSr, 16, 16},
+	{"KetjeMinor", NewKetjeMinor, 16, 16},
+	{"KetjeMajor", NewKetjeMajor, 16, 16},
+}
+
+// Outputs generated by _gen/ketje.py, a transcription of MonkeyWrap from
+// the Ketje v2 document that applies π and π⁻¹ around the reference
+// permutation. The key is 00 01 02 ..., the nonce 40 41 42 ..., and long
+// outputs are given as their Keccak-256 digest. With the keccak_xkcp tag,
+// TestKetjeMatchesXKCP also checks Ketje against XKCP.
+var ketjeVectors = map[string][]struct {
+	adLen, msgLen int
+	want          string
+}{
+	"KetjeJr": {
+		{0, 0, "8def124dfe079ee651fb84865c988cae"},
+		{3, 5, "31380f76070d9a6807f4143b17eec93eaa290c0bcb"},
+		{100, 250, "80f7104b441695bfa740948ea5273bcd7d010880e4b968566a9001d491ce0cd6"},
+	},
+	"KetjeSr": {
+		{0, 0, "c0317fb0c04b8bfaaece99ba6df68230"},
+		{3, 5, "8b00ed5108be508533b4045924856c9eb113872065"},
+		{100, 250, "3079f4140c558180a3d008b6a6efdba0cda535efb86e5abe67dab0f6c74265f5"},
+	},
+	"KetjeMinor": {
+		{0, 0, "44fcd95f2c03dce8af0fd9826c8e9a48"},
+		{3, 5, "3562e806ef894cd3457591545789cfa5213c7c932a"},
+		{100, 250, "420a07bb216ec116f28a4f2eb42448ae29d567f31b56196d22694e27721a10a0"},
+	},
+	"KetjeMajor": {
+		{0, 0, "837ab4a50d4b3c31364872dfa8f58c51"},
+		{3, 5, "c120951b78d61965c124a4ce4ec60db1011bb1f969"},
+		{100, 250, "369abe9fe020ec3d8b02318a329cf53ea8effa590865d77ab05d510f1c7638f3"},
+	},
+}
+
+func ketjeTestKey(keySize, nonceSize int) (key, nonce []byte) {
+	key = make([]byte, keySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	nonce = make([]byte, nonceSize)
+	for i := range nonce {
+		nonce[i] = byte(0x40 + i)
+	}
+	return key, nonce
+}
+
+func TestKetjeVectors(t *testing.T) {
+	for _, inst := range ketjeInstances {
+		key, nonce := ketjeTestKey(inst.keySize, inst.nonceSize)
+		aead, err := inst.new(key)
+		if err != nil {
+			t.Fatalf("%s: %v", inst.name, err)
+		}
+		if aead.NonceSize() != inst.nonceSize {
+			t.Errorf("%s: NonceSize = %d, want %d", inst.name, aead.NonceSize(), inst.nonceSize)
+		}
+		for _, v := range ketjeVectors[inst.name] {
+			ad, msg := ptn(v.adLen), ptn(v.msgLen)
+			ct := aead.Seal(nil, nonce, msg, ad)
+			got := ct
+			if len(ct) > 24 {
+				sum := Sum256(ct)
+				got = sum[:]
+			}
+			if hex.EncodeToString(got) != v.want {
+				t.Errorf("%s ad=%d msg=%d: got %x, want %s", inst.name, v.adLen, v.msgLen, got, v.want)
+			}
+
+			pt, err := aead.Open(nil, nonce, ct, ad)
+			if err != nil || !bytes.Equal(pt, msg) {
+				t.Errorf("%s ad=%d msg=%d: Open failed: %v", inst.name, v.adLen, v.msgLen, err)
+			}
+		}
+	}
+}
+
+// TestKetjeChained seals every pair of lengths around one, two and three
+// blocks, and compares the SHAKE128 digest of all the ciphertexts with the
+// one printed by _gen/ketje.py.
+func TestKetjeChained(t *testing.T) {
+	chained := map[string]struct {
+		rho  int
+		want string
+	}{
+		"KetjeJr":    {2, "2c5d755d9991cce85b1e3ccee4d8c76726d172ac3cd996e6530ff9caf0633d10"},
+		"KetjeSr":    {4, "79a89e379bc553c7a96e4d3068bb2aeb16c7ed07d60be38625fd507ceede1567"},
+		"KetjeMinor": {16, "772731abbdf24db0825d9739b2aeffca36966690c8cee0bea12d132fc907da38"},
+		"KetjeMajor": {32, "1a075a41f1e6340e2a442f333326a497d8ab05556d30f9a9cef978d296b96179"},
+	}
+	for _, inst := range ketjeInstances {
+		key, nonce := ketjeTestKey(inst.keySize, inst.nonceSize)
+		aead, err := inst.new(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lengths := chainedLengths(chained[inst.name].rho)
+		check := NewShake128()
+		for _, adLen := range lengths {
+			for _, msgLen := range lengths {
+				check.Write(aead.Seal(nil, nonce, ptn(msgLen), ptn(adLen)))
+			}
+		}
+		got := make([]byte, 32)
+		check.Read(got)
+		if hex.EncodeToString(got) != chained[inst.name].want {
+			t.Errorf("%s: chained check = %x, want %s", inst.name, got, chained[inst.name].want)
+		}
+	}
+}
+
+// chainedLengths returns the lengths around one, two and three blocks of
+// size rho, in increasing order and without duplicates.
+func chainedLengths(rho int) []int {
+	var lengths []int
+	for _, n := range []int{0, 1, rho - 1, rho, rho + 1, 2 * rho, 2*rho + 1, 3*rho + 5} {
+		if len(lengths) == 0 || n > lengths[len(lengths)-1] {
+			lengths = append(lengths, n)
+		}
+	}
+	return lengths
+}
+
+func TestKetjeTamper(t *testing.T) {
+	for _, inst := range ketjeInstances {
+		key, nonce := ketjeTestKey(inst.keySize, inst.nonceSize)
+		aead, err := inst.new(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ad, msg := []byte("header"), ptn(70)
+		ct := aead.Seal(nil, nonce, msg, ad)
+		for i := range ct {
+			bad := bytes.Clone(ct)
+			bad[i] ^= 0x80
+			if _, err := aead.Open(nil, nonce, bad, ad); err == nil {
+				t.Fatalf("%s: Open accepted a ciphertext modified at byte %d", inst.name, i)
+			}
+		}
+		if _, err := aead.Open(nil, nonce, ct, []byte("headex")); err == nil {
+			t.Errorf("%s: Open accepted modified associated data", inst.name)
+		}
+
+		// Sealing in place must give the same result.
+		buf := bytes.Clone(msg)
+		if got := aead.Seal(buf[:0], nonce, buf, ad); !bytes.Equal(got, ct) {
+			t.Errorf("%s: in-place Seal differs", inst.name)
+		}
+	}
+}
+
+func TestKetjeKeySize(t *testing.T) {
+	for _, inst := range ketjeInstances {
+		if _, err := inst.new(make([]byte, inst.keySize-1)); err == nil {
+			t.Errorf("%s: accepted a %d-byte key", inst.name, inst.keySize-1)
+		}
+	}
+	if _, err := NewKetjeJr(make([]byte, 13)); err == nil {
+		t.Error("KetjeJr: accepted a key too long for the state")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/filecoin-project/go-keccak/internal/xkcp"
+)
+
+// TestKetjeMatchesXKCP checks the four instances of Ketje against XKCP, the
+// Keccak team's implementation, for every pair of lengths around one, two
+// and three blocks, with the smallest and the largest keys.
+func TestKetjeMatchesXKCP(t *testing.T) {
+	instances := map[string]struct {
+		xkcp       xkcp.Ketje
+		width, rho int
+	}{
+		"KetjeJr":    {xkcp.KetjeJr, 25, 2},
+		"KetjeSr":    {xkcp.KetjeSr, 50, 4},
+		"KetjeMinor": {xkcp.KetjeMinor, 100, 16},
+		"KetjeMajor": {xkcp.KetjeMajor, 200, 32},
+	}
+	for _, inst := range ketjeInstances {
+		x := instances[inst.name]
+		for _, keySize := range []int{inst.keySize, x.width - 3 - inst.nonceSize} {
+			key, nonce := ketjeTestKey(keySize, inst.nonceSize)
+			aead, err := inst.new(key)
+			if err != nil {
+				t.Fatalf("%s: %v", inst.name, err)
+			}
+			lengths := chainedLengths(x.rho)
+			for _, adLen := range lengths {
+				for _, msgLen := range lengths {
+					ad, msg := ptn(adLen), ptn(msgLen)
+					got := aead.Seal(nil, nonce, msg, ad)
+					want := x.xkcp.Seal(key, nonce, msg, ad)
+					if !bytes.Equal(got, want) {
+						t.Errorf("%s key=%d ad=%d msg=%d: got %x, XKCP %x", inst.name, keySize, adLen, msgLen, got, want)
+					}
+				}
+			}
+		}
+	}
+}
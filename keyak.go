@@ -0,0 +1,252 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file implements River Keyak and Lake Keyak, the single-piston
+// members of the Keyak v2 authenticated encryption family [1], built on
+// the Motorist mode over Keccak-p[800, 12] and Keccak-p[1600, 12].
+//
+// [1] https://keccak.team/files/Keyakv2-doc2.2.pdf
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+)
+
+const (
+	keyakRounds = 12
+
+	// keyakKeyPackSize is the size of the key pack that starts the
+	// secret and unique value absorbed by a new session.
+	keyakKeyPackSize = 40
+
+	keyakMinKeySize = 16
+	keyakMaxKeySize = keyakKeyPackSize - 2
+	keyakNonceSize  = 16
+	keyakTagSize    = 16
+)
+
+var errKeyakFailed = errors.New("keccak: Keyak session has failed")
+
+// KeyakSession is a Keyak session. Every message sealed or opened in a
+// session is authenticated together with all the previous ones, so the
+// messages must be opened in the order they were sealed, and a message
+// may carry only associated data.
+//
+// Once Open fails, the session can no longer be used.
+type KeyakSession struct {
+	s      state
+	rs     int // rate for the message, in bytes
+	ra     int // rate for the associated data, in bytes
+	et     int // offset of the first keystream byte
+	failed bool
+}
+
+func newKeyakSession(key, nonce []byte, width, rs, ra int) (*KeyakSession, error) {
+	if len(key) < keyakMinKeySize || len(key) > keyakMaxKeySize {
+		return nil, errors.New("keccak: invalid Keyak key size")
+	}
+
+	k := &KeyakSession{rs: rs, ra: ra}
+	k.s.rounds = keyakRounds
+	if width != 200 {
+		k.s.width = width
+	}
+
+	// The secret and unique value is the key pack and the nonce, followed
+	// by the number of pistons and the index of this one.
+	suv := make([]byte, keyakKeyPackSize, keyakKeyPackSize+len(nonce)+2)
+	suv[0] = keyakKeyPackSize
+	copy(suv[1:], key)
+	suv[1+len(key)] = 0x01
+	suv = append(suv, nonce...)
+	suv = append(suv, 1, 0)
+	for {
+		suv = suv[k.inject(suv, false):]
+		if len(suv) == 0 {
+			break
+		}
+		k.spark(false, 0)
+	}
+	k.spark(true, 0)
+	return k, nil
+}
+
+// NewRiverKeyakSession starts a River Keyak session, over
+// Keccak-p[800, 12]. The key must be between 16 and 38 bytes long, and the
+// nonce must not be reused with the same key.
+func NewRiverKeyakSession(key, nonce []byte) (*KeyakSession, error) {
+	return newKeyakSession(key, nonce, 100, 68, 96)
+}
+
+// NewLakeKeyakSession starts a Lake Keyak session, over
+// Keccak-p[1600, 12]. The key must be between 16 and 38 bytes long, and
+// the nonce must not be reused with the same key.
+func NewLakeKeyakSession(key, nonce []byte) (*KeyakSession, error) {
+	return newKeyakSession(key, nonce, 200, 168, 192)
+}
+
+// Overhead returns the size of the tag appended to each message.
+func (k *KeyakSession) Overhead() int { return keyakTagSize }
+
+// crypt encrypts or decrypts up to one block of in into out and returns
+// the number of bytes processed. The state absorbs the plaintext.
+func (k *KeyakSession) crypt(out, in []byte, unwrap bool) int {
+	n := min(len(in), k.rs-k.et)
+	a := k.s.a[k.et : k.et+n]
+	if unwrap {
+		subtle.XORBytes(out[:n], a, in[:n])
+		subtle.XORBytes(a, a, out[:n])
+	} else {
+		subtle.XORBytes(a, a, in[:n])
+		copy(out, a)
+	}
+	k.s.a[k.ra+1] ^= byte(k.et + n)
+	return n
+}
+
+// inject absorbs up to one block of associated data, after the message
+// block if crypting is set, and returns the number of bytes absorbed.
+func (k *KeyakSession) inject(x []byte, crypting bool) int {
+	offset := 0
+	if crypting {
+		offset = k.rs
+	}
+	k.s.a[k.ra+2] ^= byte(offset)
+	n := subtle.XORBytes(k.s.a[offset:k.ra], k.s.a[offset:k.ra], x)
+	k.s.a[k.ra+3] ^= byte(offset + n)
+	return n
+}
+
+// spark applies the permutation. At the end of a message, it also
+// records the size of the tag that is taken from the new state.
+func (k *KeyakSession) spark(eom bool, tagSize int) {
+	if eom {
+		if tagSize == 0 {
+			k.s.a[k.ra] ^= 0xff
+		} else {
+			k.s.a[k.ra] ^= byte(tagSize)
+		}
+	}
+	k.s.permute()
+	k.et = tagSize
+}
+
+// wrap processes a message and its associated data, and writes the tag
+// to tag.
+func (k *KeyakSession) wrap(out, in, additionalData, tag []byte, unwrap bool) {
+	for len(in) > 0 {
+		n := k.crypt(out, in, unwrap)
+		in, out = in[n:], out[n:]
+		additionalData = additionalData[k.inject(additionalData, true):]
+		if len(in) > 0 || len(additionalData) > 0 {
+			k.spark(false, 0)
+		}
+	}
+	for len(additionalData) > 0 {
+		additionalData = additionalData[k.inject(additionalData, false):]
+		if len(additionalData) > 0 {
+			k.spark(false, 0)
+		}
+	}
+	k.spark(true, keyakTagSize)
+	copy(tag, k.s.a[:keyakTagSize])
+}
+
+// Seal encrypts and authenticates the next message of the session, and
+// appends the result to dst. The plaintext and dst must overlap exactly
+// or not at all.
+func (k *KeyakSession) Seal(dst, plaintext, additionalData []byte) []byte {
+	if k.failed {
+		panic(errKeyakFailed.Error())
+	}
+
+	ret, out := sliceForAppend(dst, len(plaintext)+keyakTagSize)
+	if inexactOverlap(out, plaintext) {
+		panic("keccak: invalid buffer overlap")
+	}
+	k.wrap(out[:len(plaintext)], plaintext, additionalData, out[len(plaintext):], false)
+	return ret
+}
+
+// Open decrypts and authenticates the next message of the session, and
+// appends the result to dst. If authentication fails, the session can no
+// longer be used. The ciphertext and dst must overlap exactly or not at
+// all.
+func (k *KeyakSession) Open(dst, ciphertext, additionalData []byte) ([]byte, error) {
+	if k.failed {
+		return nil, errKeyakFailed
+	}
+	if len(ciphertext) < keyakTagSize {
+		k.failed = true
+		return nil, errOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-keyakTagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-keyakTagSize]
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if inexactOverlap(out, ciphertext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	var expected [keyakTagSize]byte
+	k.wrap(out, ciphertext, additionalData, expected[:], true)
+	if subtle.ConstantTimeCompare(expected[:], tag) != 1 {
+		clear(out)
+		k.failed = true
+		return nil, errOpen
+	}
+	return ret, nil
+}
+
+type keyak struct {
+	key        []byte
+	newSession func(key, nonce []byte) (*KeyakSession, error)
+}
+
+func newKeyak(key []byte, newSession func(key, nonce []byte) (*KeyakSession, error)) (cipher.AEAD, error) {
+	if len(key) < keyakMinKeySize || len(key) > keyakMaxKeySize {
+		return nil, errors.New("keccak: invalid Keyak key size")
+	}
+	return &keyak{key: append([]byte(nil), key...), newSession: newSession}, nil
+}
+
+// NewRiverKeyak returns a cipher.AEAD that seals each message in a new
+// River Keyak session with a 16-byte nonce. The key must be between 16 and
+// 38 bytes long.
+func NewRiverKeyak(key []byte) (cipher.AEAD, error) {
+	return newKeyak(key, NewRiverKeyakSession)
+}
+
+// NewLakeKeyak returns a cipher.AEAD that seals each message in a new
+// Lake Keyak session with a 16-byte nonce. The key must be between 16 and
+// 38 bytes long.
+func NewLakeKeyak(key []byte) (cipher.AEAD, error) {
+	return newKeyak(key, NewLakeKeyakSession)
+}
+
+func (k *keyak) NonceSize() int { return keyakNonceSize }
+
+func (k *keyak) Overhead() int { return keyakTagSize }
+
+func (k *keyak) session(nonce []byte) *KeyakSession {
+	if len(nonce) != keyakNonceSize {
+		panic("keccak: incorrect nonce length given to Keyak")
+	}
+	s, err := k.newSession(k.key, nonce)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func (k *keyak) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	return k.session(nonce).Seal(dst, plaintext, additionalData)
+}
+
+func (k *keyak) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	return k.session(nonce).Open(dst, ciphertext, additionalData)
+}
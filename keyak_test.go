@@ -0,0 +1,210 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/hex"
+	"testing"
+)
+
+var keyakInstances = []struct {
+	name       string
+	new        func(key []byte) (cipher.AEAD, error)
+	newSession func(key, nonce []byte) (*KeyakSession, error)
+	rs, ra     int
+}{
+	{"RiverKeyak", NewRiverKeyak, NewRiverKeyakSession, 68, 96},
+	{"LakeKeyak", NewLakeKeyak, NewLakeKeyakSession, 168, 192},
+}
+
+// Outputs generated by _gen/keyak.py, a transcription of the Motorist mode
+// from the Keyak v2 document. The key is 00 01 02 ..., the nonce
+// 40 41 42 ..., and long outputs are given as their Keccak-256 digest.
+// With the keccak_xkcp tag, TestKeyakMatchesXKCP also checks Keyak against
+// XKCP.
+var keyakVectors = map[string][]struct {
+	adLen, msgLen int
+	want          string
+}{
+	"RiverKeyak": {
+		{0, 0, "575b9dee467c159622d8d67bc83906ee"},
+		{3, 5, "4fd9e3de7565b7e7a9890cfe125651df03082a4ad4"},
+		{300, 500, "3054b2a36c2c76316c76b7ce71c05bf33d7914ca1d4ddb762c54f824cab38585"},
+	},
+	"LakeKeyak": {
+		{0, 0, "dbc9afd1a586288172695c8d699bef40"},
+		{3, 5, "71a19919a4f740aded9077a20ad054cd74825ec429"},
+		{300, 500, "ea7c6d56e861e4f70bf44b208a2f6918f556b49a745896f860b2700efbbf04af"},
+	},
+}
+
+// Outputs of three messages sealed in one session: a short message with
+// associated data, associated data only, and a message only.
+var keyakSessionVectors = map[string][3]string{
+	"RiverKeyak": {
+		"adeab3fa330de6cad3c97a8e0b98f6eb56981aba10cd24116ab6882a1fce374f",
+		"08672030c3e5d6aee7f2a4c114161ad7",
+		"86e27dc2578154f90fcaf6259e6b60e5d483de090bafb618719c2dddacabf1de",
+	},
+	"LakeKeyak": {
+		"076bf1e4ad9dd231b1932d865c073342bef991d56201f0048c8bcf03d0ab0ab6",
+		"54cf67eb992deb94efafd8a3e2f1aa9b",
+		"0d17a1012e6d4ddc7577bb61a524f801e5c2ac8ed47347621f1f9712e05df5a3",
+	},
+}
+
+func keyakTestKey() (key, nonce []byte) {
+	return ketjeTestKey(16, 16)
+}
+
+func keyakDigest(b []byte) string {
+	if len(b) > 24 {
+		sum := Sum256(b)
+		b = sum[:]
+	}
+	return hex.EncodeToString(b)
+}
+
+func TestKeyakVectors(t *testing.T) {
+	key, nonce := keyakTestKey()
+	for _, inst := range keyakInstances {
+		aead, err := inst.new(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range keyakVectors[inst.name] {
+			ad, msg := ptn(v.adLen), ptn(v.msgLen)
+			ct := aead.Seal(nil, nonce, msg, ad)
+			if got := keyakDigest(ct); got != v.want {
+				t.Errorf("%s ad=%d msg=%d: got %s, want %s", inst.name, v.adLen, v.msgLen, got, v.want)
+			}
+			pt, err := aead.Open(nil, nonce, ct, ad)
+			if err != nil || !bytes.Equal(pt, msg) {
+				t.Errorf("%s ad=%d msg=%d: Open failed: %v", inst.name, v.adLen, v.msgLen, err)
+			}
+		}
+	}
+}
+
+func TestKeyakSession(t *testing.T) {
+	key, nonce := keyakTestKey()
+	messages := []struct{ msg, ad []byte }{
+		{ptn(10), []byte("ad1")},
+		{nil, ptn(200)},
+		{ptn(100), nil},
+	}
+	for _, inst := range keyakInstances {
+		sealer, err := inst.newSession(key, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opener, _ := inst.newSession(key, nonce)
+		for i, m := range messages {
+			ct := sealer.Seal(nil, m.msg, m.ad)
+			if got, want := keyakDigest(ct), keyakSessionVectors[inst.name][i]; got != want {
+				t.Errorf("%s message %d: got %s, want %s", inst.name, i, got, want)
+			}
+			pt, err := opener.Open(nil, ct, m.ad)
+			if err != nil || !bytes.Equal(pt, m.msg) {
+				t.Errorf("%s message %d: Open failed: %v", inst.name, i, err)
+			}
+		}
+	}
+}
+
+// TestKeyakChained seals, in one session, a message for every pair of
+// lengths around the two rates, so that each is authenticated together
+// with all the ones before it. It then starts a session for every key size,
+// keyed with the start of the last ciphertext, and seals a message in
+// each. The SHAKE128 digest of all the ciphertexts is the one printed by
+// _gen/keyak.py.
+func TestKeyakChained(t *testing.T) {
+	chained := map[string]string{
+		"RiverKeyak": "8597ef582586c302fbd064ce94cf1260e0ec9733915fab2de17c0fc77b22cec2",
+		"LakeKeyak":  "2c8550b5ea5e642b476a057ca27eaf87c58c6a205ca66a5c3fbf546a75e5634a",
+	}
+	key, nonce := keyakTestKey()
+	for _, inst := range keyakInstances {
+		rs, ra := inst.rs, inst.ra
+		lengths := []int{0, 1, rs - 1, rs, rs + 1, ra - 1, ra, ra + 1, 2*rs + 1}
+		check := NewShake128()
+		s, err := inst.newSession(key, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ct []byte
+		for _, adLen := range lengths {
+			for _, msgLen := range lengths {
+				ct = s.Seal(nil, ptn(msgLen), ptn(adLen))
+				check.Write(ct)
+			}
+		}
+		for keySize := keyakMinKeySize; keySize <= keyakMaxKeySize; keySize++ {
+			s, err := inst.newSession(ct[:keySize], ct[:keyakNonceSize])
+			if err != nil {
+				t.Fatal(err)
+			}
+			ct = s.Seal(nil, ptn(keySize), nil)
+			check.Write(ct)
+		}
+		got := make([]byte, 32)
+		check.Read(got)
+		if hex.EncodeToString(got) != chained[inst.name] {
+			t.Errorf("%s: chained check = %x, want %s", inst.name, got, chained[inst.name])
+		}
+	}
+}
+
+func TestKeyakSessionFailure(t *testing.T) {
+	key, nonce := keyakTestKey()
+	for _, inst := range keyakInstances {
+		sealer, _ := inst.newSession(key, nonce)
+		opener, _ := inst.newSession(key, nonce)
+		first := sealer.Seal(nil, ptn(50), nil)
+		second := sealer.Seal(nil, ptn(50), nil)
+
+		// Messages must be opened in order.
+		if _, err := opener.Open(nil, second, nil); err == nil {
+			t.Fatalf("%s: opened the second message first", inst.name)
+		}
+		if _, err := opener.Open(nil, first, nil); err != errKeyakFailed {
+			t.Errorf("%s: Open after a failure returned %v", inst.name, err)
+		}
+	}
+}
+
+func TestKeyakTamper(t *testing.T) {
+	key, nonce := keyakTestKey()
+	for _, inst := range keyakInstances {
+		aead, _ := inst.new(key)
+		ad, msg := []byte("header"), ptn(200)
+		ct := aead.Seal(nil, nonce, msg, ad)
+		for i := range ct {
+			bad := bytes.Clone(ct)
+			bad[i] ^= 0x01
+			if _, err := aead.Open(nil, nonce, bad, ad); err == nil {
+				t.Fatalf("%s: Open accepted a ciphertext modified at byte %d", inst.name, i)
+			}
+		}
+		if _, err := aead.Open(nil, nonce, ct, []byte("headex")); err == nil {
+			t.Errorf("%s: Open accepted modified associated data", inst.name)
+		}
+
+		buf := bytes.Clone(ct)
+		if pt, err := aead.Open(buf[:0], nonce, buf, ad); err != nil || !bytes.Equal(pt, msg) {
+			t.Errorf("%s: in-place Open failed: %v", inst.name, err)
+		}
+	}
+}
+
+func TestKeyakKeySize(t *testing.T) {
+	for _, size := range []int{15, 39} {
+		if _, err := NewLakeKeyak(make([]byte, size)); err == nil {
+			t.Errorf("accepted a %d-byte key", size)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/filecoin-project/go-keccak/internal/xkcp"
+)
+
+// TestKeyakMatchesXKCP checks River Keyak and Lake Keyak against XKCP, the
+// Keccak team's implementation, for every pair of lengths around the two
+// rates, each sealed in a new session and all sealed in one session, with
+// the smallest and the largest keys.
+func TestKeyakMatchesXKCP(t *testing.T) {
+	instances := map[string]xkcp.Keyak{
+		"RiverKeyak": xkcp.RiverKeyak,
+		"LakeKeyak":  xkcp.LakeKeyak,
+	}
+	for _, inst := range keyakInstances {
+		rs, ra := inst.rs, inst.ra
+		lengths := []int{0, 1, rs - 1, rs, rs + 1, ra - 1, ra, ra + 1, 2*ra + 5}
+		for _, keySize := range []int{keyakMinKeySize, keyakMaxKeySize} {
+			key, nonce := ketjeTestKey(keySize, keyakNonceSize)
+			aead, err := inst.new(key)
+			if err != nil {
+				t.Fatalf("%s: %v", inst.name, err)
+			}
+			session, err := inst.newSession(key, nonce)
+			if err != nil {
+				t.Fatalf("%s: %v", inst.name, err)
+			}
+			xSession := instances[inst.name].NewSession(key, nonce)
+
+			for _, adLen := range lengths {
+				for _, msgLen := range lengths {
+					ad, msg := ptn(adLen), ptn(msgLen)
+					x := instances[inst.name].NewSession(key, nonce)
+					want := x.Seal(msg, ad)
+					x.Close()
+					if got := aead.Seal(nil, nonce, msg, ad); !bytes.Equal(got, want) {
+						t.Errorf("%s key=%d ad=%d msg=%d: got %x, XKCP %x", inst.name, keySize, adLen, msgLen, got, want)
+					}
+
+					want = xSession.Seal(msg, ad)
+					if got := session.Seal(nil, msg, ad); !bytes.Equal(got, want) {
+						t.Fatalf("%s key=%d: in a session, ad=%d msg=%d: got %x, XKCP %x", inst.name, keySize, adLen, msgLen, got, want)
+					}
+				}
+			}
+			xSession.Close()
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "hash"
+
+// keyedKeccak is a legacy Keccak hash which has absorbed a padded key
+// before the message. Since the sponge does not suffer from length
+// extension, this prefix construction is a secure MAC.
+type keyedKeccak struct {
+	*state
+
+	// initBlock is bytepad(encode_string(K)), a whole number of blocks
+	// which is absorbed on construction and on every Reset.
+	initBlock []byte
+}
+
+// NewKeyedKeccak256 returns a new hash.Hash computing a Keccak-256 MAC of
+// the message keyed with key. The key is encoded with its length and
+// padded to the rate, as in KMAC, before the message is absorbed; Reset
+// restores the keyed state.
+//
+// The MAC is cheaper than HMAC with Keccak-256, but it is not compatible
+// with it or with KMAC. The key should be at least 32 bytes long.
+func NewKeyedKeccak256(key []byte) hash.Hash {
+	k := &keyedKeccak{state: newState(state{rate: rateK512, outputLen: 32, dsbyte: dsbyteKeccak})}
+	k.initBlock = bytepad(appendEncodeString(nil, key), rateK512)
+	k.Write(k.initBlock)
+	return k
+}
+
+// Reset restores the keyed state, discarding any message written so far.
+func (k *keyedKeccak) Reset() {
+	k.state.Reset()
+	k.Write(k.initBlock)
+}
+
+// Clone returns a copy of the MAC in its current state, which also resets
+// to the keyed state.
+func (k *keyedKeccak) Clone() ShakeHash {
+	return &keyedKeccak{state: k.clone(), initBlock: k.initBlock}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Tags generated by _gen/keyed.py, as Keccak-256 of
+// bytepad(encode_string(K), 136) || M with K = 40 41 42 ... 5f.
+func TestKeyedKeccak256(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(0x40 + i)
+	}
+	h := NewKeyedKeccak256(key)
+	for _, tc := range []struct {
+		msg  []byte
+		want string
+	}{
+		{nil, "1e56e59c8a1dde005a43e54a4031b336781f2cd8d235e97955fa359a2a725e5c"},
+		{[]byte("abc"), "5156a04e41200e20c2d8f44f6e39f59ddb62cf88a0112c892c93690f2574aaa0"},
+		{ptn(300), "9dc10dab8277c351390a61a32768ca5ff0a65fee9e01c9c50718a9b3eb3cedf5"},
+	} {
+		// Reset must restore the keyed state after the previous message.
+		h.Write([]byte("garbage"))
+		h.Reset()
+		h.Write(tc.msg)
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("len=%d: got %s, want %s", len(tc.msg), got, tc.want)
+		}
+	}
+
+	if h.Size() != 32 || h.BlockSize() != rateK512 {
+		t.Errorf("Size = %d, BlockSize = %d", h.Size(), h.BlockSize())
+	}
+}
+
+func TestKeyedKeccak256Clone(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(0x40 + i)
+	}
+	h := NewKeyedKeccak256(key)
+	h.Write([]byte("ab"))
+	c := h.(ShakeHash).Clone()
+	c.Write([]byte("c"))
+	want := "5156a04e41200e20c2d8f44f6e39f59ddb62cf88a0112c892c93690f2574aaa0"
+	if got := hex.EncodeToString(c.Sum(nil)); got != want {
+		t.Errorf("clone: got %s, want %s", got, want)
+	}
+
+	// Reset on the clone must restore the keyed state, not an empty one.
+	c.Reset()
+	c.Write([]byte("abc"))
+	if got := hex.EncodeToString(c.Sum(nil)); got != want {
+		t.Errorf("clone after Reset: got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !s390x || purego || noasm || !gc
+
+package keccak
+
+// useKIMD reports whether the KIMD instruction of IBM Z is used.
+const useKIMD = false
+
+func kimdPermute(a *[200]byte) {
+	panic("keccak: KIMD is not available")
+}
+
+func kimdAbsorb(a *[200]byte, rate int, p []byte) int {
+	panic("keccak: KIMD is not available")
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s390x && !purego && !noasm && gc
+
+package keccak
+
+// This file uses the KIMD (compute intermediate message digest) instruction
+// of IBM Z to run Keccak-f[1600]. KIMD absorbs whole blocks into a state
+// kept in the byte order of the sponge, so it serves every function built
+// on the full permutation, whatever its domain separation byte: the padding
+// is always done in software, where it only costs two XORs, and there is no
+// need for KLMD. The function code only selects the rate.
+//
+// See z/Architecture Principles of Operation, Fourteenth Edition,
+// https://www.ibm.com/docs/en/module_1678991624569/pdf/SA22-7832-13.pdf.
+
+// KIMD function codes, from Figure 7-207 of the Principles of Operation.
+const (
+	kimdSHA3224  = 32
+	kimdSHA3256  = 33
+	kimdSHA3384  = 34
+	kimdSHA3512  = 35
+	kimdShake128 = 36
+	kimdShake256 = 37
+)
+
+// useKIMD reports whether the KIMD instruction is used.
+var useKIMD = hasKIMD && backendAllowed("kimd")
+
+// kimd absorbs src, whose length must be a multiple of the rate of
+// function, into a. It is implemented in kimd_s390x.s.
+//
+//go:noescape
+func kimd(function uint64, a *[200]byte, src []byte)
+
+var kimdZeros [rateK256]byte
+
+// kimdPermute applies Keccak-f[1600] to a. Absorbing a block of zeros
+// leaves only the permutation.
+func kimdPermute(a *[200]byte) {
+	kimd(kimdShake128, a, kimdZeros[:])
+}
+
+// kimdAbsorb absorbs the whole blocks at the start of p into a, for a sponge
+// of the given rate, and returns the number of bytes absorbed. It absorbs
+// nothing if KIMD has no function code for that rate.
+func kimdAbsorb(a *[200]byte, rate int, p []byte) int {
+	var function uint64
+	switch rate {
+	case rateK256:
+		function = kimdShake128
+	case rateK448:
+		function = kimdSHA3224
+	case rateK512:
+		function = kimdSHA3256
+	case rateK768:
+		function = kimdSHA3384
+	case rateK1024:
+		function = kimdSHA3512
+	default:
+		return 0
+	}
+	n := len(p) / rate * rate
+	if n > 0 {
+		kimd(function, a, p[:n])
+	}
+	return n
+}
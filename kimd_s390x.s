@@ -0,0 +1,19 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s390x && !purego && !noasm && gc
+
+#include "textflag.h"
+
+// func kimd(function uint64, a *[200]byte, src []byte)
+TEXT ·kimd(SB), NOFRAME|NOSPLIT, $0-40
+	MOVD function+0(FP), R0
+	MOVD a+8(FP), R1
+	LMG  src+16(FP), R2, R3 // R2=base, R3=len
+
+continue:
+	KIMD R0, R2
+	BVS  continue // continue if interrupted
+	MOVD $0, R0   // reset R0 for pre-go1.8 compilers
+	RET
@@ -0,0 +1,65 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s390x && !purego && !noasm && gc
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestKIMDPermutation(t *testing.T) {
+	if !useKIMD {
+		t.Skip("KIMD does not support SHA-3 on this CPU")
+	}
+	for i, want := range keccakF1600Vectors {
+		var a [200]byte
+		for range i + 1 {
+			kimdPermute(&a)
+		}
+		for j := range want {
+			if got := binary.LittleEndian.Uint64(a[j*8:]); got != want[j] {
+				t.Errorf("KIMD permutation applied %d times: lane %d = %016X, want %016X", i+1, j, got, want[j])
+			}
+		}
+	}
+}
+
+// Hashing with KIMD must match hashing with the generic permutation, for
+// every rate and domain separation byte.
+func TestKIMDMatchesGeneric(t *testing.T) {
+	if !useKIMD {
+		t.Skip("KIMD does not support SHA-3 on this CPU")
+	}
+	msg := ptn(1000)
+	for name, newHash := range map[string]func() ShakeHash{
+		"SHA3-224":   func() ShakeHash { return &state{rate: rateK448, outputLen: 28, dsbyte: dsbyteSHA3} },
+		"SHA3-512":   func() ShakeHash { return &state{rate: rateK1024, outputLen: 64, dsbyte: dsbyteSHA3} },
+		"Keccak-256": NewLegacyKeccakXOF256,
+		"Keccak-384": func() ShakeHash { return &state{rate: rateK768, outputLen: 48, dsbyte: dsbyteKeccak} },
+		"SHAKE128":   NewShake128,
+	} {
+		for _, n := range []int{0, 1, 71, 72, 136, 168, 169, 1000} {
+			useKIMD = true
+			h := newHash()
+			h.Write(msg[:n])
+			got := make([]byte, 500)
+			h.Read(got)
+
+			useKIMD = false
+			h = newHash()
+			h.Write(msg[:n])
+			want := make([]byte, 500)
+			h.Read(want)
+			useKIMD = true
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s(%d bytes) with KIMD = %x, want %x", name, n, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the KMAC128 and KMAC256 message authentication codes
+// and their KMACXOF variants, as specified in NIST SP 800-185 [1].
+//
+// [1] https://doi.org/10.6028/NIST.SP.800-185
+
+import "hash"
+
+// kmac is a KMAC or KMACXOF instance. It is a cSHAKE sponge with function
+// name "KMAC" which has absorbed the padded key, and which absorbs
+// right_encode(L) before producing output.
+type kmac struct {
+	*state
+
+	// initBlock is bytepad(encode_string("KMAC") || encode_string(S))
+	// followed by bytepad(encode_string(K)). It is absorbed on construction
+	// and on every Reset, so that the keyed state can be restored.
+	initBlock []byte
+
+	// xof is set for the KMACXOF variants, which encode an output length
+	// of zero so that the output can be read to an arbitrary length.
+	xof bool
+}
+
+func newKMAC(key, S []byte, rate, outputLen int, xof bool) *kmac {
+	k := &kmac{state: newState(state{rate: rate, outputLen: outputLen, dsbyte: dsbyteCShake}), xof: xof}
+	var prefix []byte
+	prefix = appendEncodeString(prefix, []byte("KMAC"))
+	prefix = appendEncodeString(prefix, S)
+	k.initBlock = bytepad(prefix, rate)
+	k.initBlock = append(k.initBlock, bytepad(appendEncodeString(nil, key), rate)...)
+	k.Write(k.initBlock)
+	return k
+}
+
+// Reset restores the keyed state, discarding any message written so far.
+func (k *kmac) Reset() {
+	k.state.Reset()
+	k.Write(k.initBlock)
+}
+
+// finalize absorbs the encoded output length that terminates the message.
+func (k *kmac) finalize() {
+	if k.xof {
+		k.Write(rightEncode(0))
+	} else {
+		k.Write(rightEncode(uint64(k.outputLen) * 8))
+	}
+}
+
+// Read squeezes output from the MAC. The first call finalizes the message,
+// and subsequent calls to Write or Sum will panic.
+func (k *kmac) Read(out []byte) (n int, err error) {
+	if k.state.state == spongeAbsorbing {
+		k.finalize()
+	}
+	return k.state.Read(out)
+}
+
+// Sum appends the MAC of the data written so far to in. It does not change
+// the underlying state, and panics if any output has already been read.
+func (k *kmac) Sum(in []byte) []byte {
+	if k.state.state != spongeAbsorbing {
+		panic("keccak: Sum after Read")
+	}
+
+	dup := k.clone()
+	dup.finalize()
+	tag := make([]byte, dup.outputLen)
+	_, _ = dup.state.Read(tag)
+	return append(in, tag...)
+}
+
+// AppendSum appends the MAC of the data written so far to dst and returns
+// the resulting slice, without allocating if dst has room for it. It does
+// not change the underlying state, and panics if any output has already
+// been read.
+func (k *kmac) AppendSum(dst []byte) []byte {
+	if k.state.state != spongeAbsorbing {
+		panic("keccak: AppendSum after Read")
+	}
+	s := k.finalized()
+	return s.AppendSum(dst)
+}
+
+// Sum256 returns the MAC of the data written so far, for a KMAC with a
+// 32-byte output. It does not change the underlying state, and panics if
+// any output has already been read or if the output length is different.
+func (k *kmac) Sum256() [32]byte {
+	if k.state.state != spongeAbsorbing {
+		panic("keccak: Sum256 after Read")
+	}
+	s := k.finalized()
+	return s.Sum256()
+}
+
+// Sum512 returns the MAC of the data written so far, for a KMAC with a
+// 64-byte output. It does not change the underlying state, and panics if
+// any output has already been read or if the output length is different.
+func (k *kmac) Sum512() [64]byte {
+	if k.state.state != spongeAbsorbing {
+		panic("keccak: Sum512 after Read")
+	}
+	s := k.finalized()
+	return s.Sum512()
+}
+
+// finalized returns a copy of the state which has absorbed the encoded
+// output length, leaving k unchanged. The methods of the embedded state
+// would otherwise squeeze the MAC without it.
+func (k *kmac) finalized() state {
+	s := *k.state
+	dup := kmac{state: &s, xof: k.xof}
+	dup.finalize()
+	return s
+}
+
+func (k *kmac) clone() *kmac {
+	return &kmac{state: k.state.clone(), initBlock: k.initBlock, xof: k.xof}
+}
+
+// Clone returns a copy of the KMAC instance in its current state.
+func (k *kmac) Clone() ShakeHash {
+	return k.clone()
+}
+
+// NewKMAC128 returns a new KMAC128 hash.Hash keyed with key and customized
+// by S, producing tags of outputLen bytes. The output length is bound into
+// the MAC, so tags of different lengths are unrelated. The key should be at
+// least 16 bytes long for the full 128-bit security strength.
+func NewKMAC128(key, S []byte, outputLen int) hash.Hash {
+	if outputLen <= 0 {
+		panic("keccak: invalid KMAC output length")
+	}
+	return newKMAC(key, S, rateK256, outputLen, false)
+}
+
+// NewKMAC256 returns a new KMAC256 hash.Hash keyed with key and customized
+// by S, producing tags of outputLen bytes. The output length is bound into
+// the MAC, so tags of different lengths are unrelated. The key should be at
+// least 32 bytes long for the full 256-bit security strength.
+func NewKMAC256(key, S []byte, outputLen int) hash.Hash {
+	if outputLen <= 0 {
+		panic("keccak: invalid KMAC output length")
+	}
+	return newKMAC(key, S, rateK512, outputLen, false)
+}
+
+// NewKMACXOF128 returns a new KMACXOF128 ShakeHash keyed with key and
+// customized by S. Its output can be read to an arbitrary length; Sum
+// returns the first 32 bytes.
+func NewKMACXOF128(key, S []byte) ShakeHash {
+	return newKMAC(key, S, rateK256, 32, true)
+}
+
+// NewKMACXOF256 returns a new KMACXOF256 ShakeHash keyed with key and
+// customized by S. Its output can be read to an arbitrary length; Sum
+// returns the first 64 bytes.
+func NewKMACXOF256(key, S []byte) ShakeHash {
+	return newKMAC(key, S, rateK512, 64, true)
+}
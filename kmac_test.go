@@ -0,0 +1,104 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// Samples from NIST's KMAC_samples.pdf and KMACXOF_samples.pdf.
+var kmacKey = []byte{
+	0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5d, 0x5e, 0x5f,
+}
+
+func TestKMACSamples(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0x03}
+	for _, tc := range []struct {
+		name string
+		h    hash.Hash
+		want string
+	}{
+		{"KMAC128 #1", NewKMAC128(kmacKey, nil, 32), "e5780b0d3ea6f7d3a429c5706aa43a00fadbd7d49628839e3187243f456ee14e"},
+		{"KMAC128 #2", NewKMAC128(kmacKey, []byte("My Tagged Application"), 32), "3b1fba963cd8b0b59e8c1a6d71888b7143651af8ba0a7070c0979e2811324aa5"},
+		{"KMAC256 #4", NewKMAC256(kmacKey, []byte("My Tagged Application"), 64), "20c570c31346f703c9ac36c61c03cb64c3970d0cfc787e9b79599d273a68d2f7f69d4cc3de9d104a351689f27cf6f5951f0103f33f4f24871024d9c27773a8dd"},
+		{"KMACXOF128 #1", NewKMACXOF128(kmacKey, nil), "cd83740bbd92ccc8cf032b1481a0f4460e7ca9dd12b08a0c4031178bacd6ec35"},
+		{"KMACXOF256 #4", NewKMACXOF256(kmacKey, []byte("My Tagged Application")), "1755133f1534752aad0748f2c706fb5c784512cab835cd15676b16c0c6647fa96faa7af634a0bf8ff6df39374fa00fad9a39e322a7c92065a64eb1fb0801eb2b"},
+	} {
+		tc.h.Write(data)
+		if got := hex.EncodeToString(tc.h.Sum(nil)); got != tc.want {
+			t.Errorf("%s: Sum = %s, want %s", tc.name, got, tc.want)
+		}
+		// Sum is non-destructive, and Read agrees with it.
+		out := make([]byte, tc.h.Size())
+		tc.h.(ShakeHash).Read(out)
+		if got := hex.EncodeToString(out); got != tc.want {
+			t.Errorf("%s: Read = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestKMACReset(t *testing.T) {
+	h := NewKMAC256(kmacKey, []byte("S"), 32)
+	h.Write([]byte("message"))
+	want := h.Sum(nil)
+
+	h.Write([]byte("garbage"))
+	h.Reset()
+	h.Write([]byte("message"))
+	if got := h.Sum(nil); !bytes.Equal(got, want) {
+		t.Errorf("after Reset, KMAC256 = %x, want %x", got, want)
+	}
+}
+
+func TestKMACOutputLengthBound(t *testing.T) {
+	// Unlike a truncated XOF, KMAC binds the output length into the tag.
+	short := NewKMAC128(kmacKey, nil, 16).Sum(nil)
+	long := NewKMAC128(kmacKey, nil, 32).Sum(nil)
+	if bytes.Equal(short, long[:16]) {
+		t.Error("KMAC128 tags of different lengths share a prefix")
+	}
+}
+
+func TestKMACXOFClone(t *testing.T) {
+	h := NewKMACXOF128(kmacKey, nil)
+	h.Write([]byte("message"))
+	c := h.Clone()
+
+	a := make([]byte, 500)
+	b := make([]byte, 500)
+	h.Read(a)
+	c.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("clone produced different output")
+	}
+}
+
+// The sum methods promoted from the embedded state would leave out the
+// encoded output length, so KMAC has its own.
+func TestKMACSumMethods(t *testing.T) {
+	h := NewKMAC256(kmacKey, []byte("S"), 32)
+	h.Write([]byte("message"))
+	want := h.Sum(nil)
+	if got := h.(*kmac).AppendSum([]byte("prefix")); !bytes.Equal(got, append([]byte("prefix"), want...)) {
+		t.Errorf("AppendSum = %x, want prefix || %x", got, want)
+	}
+	if got := h.(*kmac).Sum256(); !bytes.Equal(got[:], want) {
+		t.Errorf("Sum256 = %x, want %x", got, want)
+	}
+
+	h = NewKMAC128(kmacKey, nil, 64)
+	h.Write([]byte("message"))
+	want = h.Sum(nil)
+	if got := h.(*kmac).Sum512(); !bytes.Equal(got[:], want) {
+		t.Errorf("Sum512 = %x, want %x", got, want)
+	}
+	if got := h.Sum(nil); !bytes.Equal(got, want) {
+		t.Errorf("Sum after Sum512 = %x, want %x", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file implements Kravatte, the Farfalle construction over
+// Keccak-p[1600, 6] [1], with the rolling functions of Kravatte Achouffe.
+// Kravatte is a deck function: a keyed function that takes a sequence of
+// strings and returns an arbitrarily long output.
+//
+// [1] https://keccak.team/files/Farfalle.pdf
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	kravatteRounds    = 6
+	kravatteBlockSize = 200
+
+	kravatteMinKeySize = 16
+	kravatteMaxKeySize = kravatteBlockSize - 1
+)
+
+// kravatte is the state of the Kravatte deck function: the masked key and
+// the accumulator of the strings compressed so far. Compressing a string
+// does not depend on the strings that follow, so the state can be cloned
+// by copying it to compute the output for different suffixes.
+type kravatte struct {
+	kRoll [25]uint64 // mask for the next input block
+	x     [25]uint64 // accumulator
+}
+
+// newKravatte derives the masked key from key, which must be shorter than
+// a block.
+func newKravatte(key []byte) kravatte {
+	var block [kravatteBlockSize]byte
+	copy(block[:], key)
+	block[len(key)] = 0x01
+
+	var f kravatte
+	for i := range f.kRoll {
+		f.kRoll[i] = binary.LittleEndian.Uint64(block[i*8:])
+	}
+	permute1600(&f.kRoll, kravatteRounds)
+	return f
+}
+
+// rollc is the rolling function for the input masks. It updates the last
+// row of the state as a linear feedback shift register.
+func rollc(a *[25]uint64) {
+	x0, x1 := a[20], a[21]
+	copy(a[20:24], a[21:25])
+	a[24] = bits.RotateLeft64(x0, 7) ^ x1 ^ x1>>3
+}
+
+// rolle is the rolling function for the expansion. It updates the last two
+// rows of the state as a non-linear feedback shift register.
+func rolle(a *[25]uint64) {
+	x0, x1, x2 := a[15], a[16], a[17]
+	copy(a[15:24], a[16:25])
+	a[24] = bits.RotateLeft64(x0, 7) ^ bits.RotateLeft64(x1, 18) ^ (x2 & (x1 >> 1))
+}
+
+func (f *kravatte) compressBlock(block []byte) {
+	var a [25]uint64
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(block[i*8:]) ^ f.kRoll[i]
+	}
+	permute1600(&a, kravatteRounds)
+	for i := range f.x {
+		f.x[i] ^= a[i]
+	}
+	rollc(&f.kRoll)
+}
+
+// addString compresses the next string of the input sequence, followed by
+// the suffixBits low bits of suffix and the padding. The mask is rolled
+// once more after each string, so that strings are not absorbed as if they
+// were concatenated.
+func (f *kravatte) addString(m []byte, suffix byte, suffixBits int) {
+	for len(m) >= kravatteBlockSize {
+		f.compressBlock(m[:kravatteBlockSize])
+		m = m[kravatteBlockSize:]
+	}
+	var block [kravatteBlockSize]byte
+	copy(block[:], m)
+	block[len(m)] = suffix | 1<<suffixBits
+	f.compressBlock(block[:])
+	rollc(&f.kRoll)
+}
+
+// expand writes the output of the deck function for the strings added so
+// far to out, skipping the first offset bytes.
+func (f *kravatte) expand(out []byte, offset int) {
+	y := f.x
+	permute1600(&y, kravatteRounds)
+	var block [kravatteBlockSize]byte
+	for len(out) > 0 {
+		z := y
+		permute1600(&z, kravatteRounds)
+		for i := range z {
+			binary.LittleEndian.PutUint64(block[i*8:], z[i]^f.kRoll[i])
+		}
+		rolle(&y)
+
+		if offset >= kravatteBlockSize {
+			offset -= kravatteBlockSize
+			continue
+		}
+		n := copy(out, block[offset:])
+		out = out[n:]
+		offset = 0
+	}
+}
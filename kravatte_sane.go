@@ -0,0 +1,241 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file implements the Kravatte-SANE and Kravatte-SANSE session
+// authenticated encryption modes, the Deck-SANE and Deck-SANSE modes of
+// [1] over Kravatte.
+//
+// [1] https://keccak.team/files/Farfalle.pdf
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+const kravatteTagSize = 16
+
+var errKravatteFailed = errors.New("keccak: Kravatte session has failed")
+
+func checkKravatteKey(key []byte) error {
+	if len(key) < kravatteMinKeySize || len(key) > kravatteMaxKeySize {
+		return errors.New("keccak: invalid Kravatte key size")
+	}
+	return nil
+}
+
+// KravatteSANE is a Kravatte-SANE session. Every message sealed or opened
+// in a session is authenticated together with the nonce and all the
+// previous messages, so the messages must be opened in the order they
+// were sealed, and a message may carry only associated data.
+//
+// Once Open fails, the session can no longer be used.
+type KravatteSANE struct {
+	f      kravatte
+	e      byte // alternates between messages
+	tag    [kravatteTagSize]byte
+	failed bool
+}
+
+// NewKravatteSANE starts a Kravatte-SANE session. The key must be between
+// 16 and 199 bytes long, and the nonce must not be reused with the same
+// key.
+func NewKravatteSANE(key, nonce []byte) (*KravatteSANE, error) {
+	if err := checkKravatteKey(key); err != nil {
+		return nil, err
+	}
+	s := &KravatteSANE{f: newKravatte(key)}
+	s.f.addString(nonce, 0, 0)
+	s.f.expand(s.tag[:], 0)
+	return s, nil
+}
+
+// Tag returns the tag of the last message of the session, or of the nonce
+// for a new session. Comparing the tags of a new session lets the receiver
+// authenticate the nonce before any message.
+func (s *KravatteSANE) Tag() []byte {
+	return append([]byte(nil), s.tag[:]...)
+}
+
+// Overhead returns the size of the tag appended to each message.
+func (s *KravatteSANE) Overhead() int { return kravatteTagSize }
+
+// update adds the message to the history and computes its tag.
+func (s *KravatteSANE) update(ciphertext, additionalData []byte) {
+	if len(additionalData) > 0 || len(ciphertext) == 0 {
+		s.f.addString(additionalData, s.e<<1, 2)
+	}
+	if len(ciphertext) > 0 {
+		s.f.addString(ciphertext, 1|s.e<<1, 2)
+	}
+	s.f.expand(s.tag[:], 0)
+	s.e ^= 1
+}
+
+// Seal encrypts and authenticates the next message of the session, and
+// appends the result to dst. The plaintext and dst must overlap exactly
+// or not at all.
+func (s *KravatteSANE) Seal(dst, plaintext, additionalData []byte) []byte {
+	if s.failed {
+		panic(errKravatteFailed.Error())
+	}
+
+	ret, out := sliceForAppend(dst, len(plaintext)+kravatteTagSize)
+	if inexactOverlap(out, plaintext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	// The keystream follows the tag of the previous message.
+	ciphertext := out[:len(plaintext)]
+	keystream := make([]byte, len(plaintext))
+	s.f.expand(keystream, kravatteTagSize)
+	subtle.XORBytes(ciphertext, plaintext, keystream)
+
+	s.update(ciphertext, additionalData)
+	copy(out[len(plaintext):], s.tag[:])
+	return ret
+}
+
+// Open decrypts and authenticates the next message of the session, and
+// appends the result to dst. If authentication fails, the session can no
+// longer be used. The ciphertext and dst must overlap exactly or not at
+// all.
+func (s *KravatteSANE) Open(dst, ciphertext, additionalData []byte) ([]byte, error) {
+	if s.failed {
+		return nil, errKravatteFailed
+	}
+	if len(ciphertext) < kravatteTagSize {
+		s.failed = true
+		return nil, errOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-kravatteTagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-kravatteTagSize]
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if inexactOverlap(out, ciphertext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	keystream := make([]byte, len(ciphertext))
+	s.f.expand(keystream, kravatteTagSize)
+	s.update(ciphertext, additionalData)
+	if subtle.ConstantTimeCompare(s.tag[:], tag) != 1 {
+		s.failed = true
+		return nil, errOpen
+	}
+	subtle.XORBytes(out, ciphertext, keystream)
+	return ret, nil
+}
+
+// KravatteSANSE is a Kravatte-SANSE session. SANSE derives the keystream
+// from a tag computed over the plaintext, so it does not take a nonce:
+// sessions with the same key only reveal whether they carry identical
+// sequences of messages. Every message is authenticated together with all
+// the previous messages of the session.
+//
+// Once Open fails, the session can no longer be used.
+type KravatteSANSE struct {
+	f      kravatte
+	e      byte // alternates between messages
+	failed bool
+}
+
+// NewKravatteSANSE starts a Kravatte-SANSE session. The key must be
+// between 16 and 199 bytes long.
+func NewKravatteSANSE(key []byte) (*KravatteSANSE, error) {
+	if err := checkKravatteKey(key); err != nil {
+		return nil, err
+	}
+	return &KravatteSANSE{f: newKravatte(key)}, nil
+}
+
+// Overhead returns the size of the tag appended to each message.
+func (s *KravatteSANSE) Overhead() int { return kravatteTagSize }
+
+// keystream writes the keystream for the message with the given tag to
+// out, without updating the history.
+func (s *KravatteSANSE) keystream(out, tag []byte) {
+	f := s.f
+	f.addString(tag, 0b11|s.e<<2, 3)
+	f.expand(out, 0)
+}
+
+// Seal encrypts and authenticates the next message of the session, and
+// appends the result to dst. The plaintext and dst must overlap exactly
+// or not at all.
+func (s *KravatteSANSE) Seal(dst, plaintext, additionalData []byte) []byte {
+	if s.failed {
+		panic(errKravatteFailed.Error())
+	}
+
+	ret, out := sliceForAppend(dst, len(plaintext)+kravatteTagSize)
+	if inexactOverlap(out, plaintext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	if len(additionalData) > 0 || len(plaintext) == 0 {
+		s.f.addString(additionalData, s.e<<1, 2)
+	}
+	tag := out[len(plaintext):]
+	if len(plaintext) == 0 {
+		s.f.expand(tag, 0)
+		s.e ^= 1
+		return ret
+	}
+
+	// The tag is computed over the plaintext, and the keystream over the
+	// tag, both following the history so far.
+	f := s.f
+	f.addString(plaintext, 0b10|s.e<<2, 3)
+	f.expand(tag, 0)
+	keystream := make([]byte, len(plaintext))
+	s.keystream(keystream, tag)
+	subtle.XORBytes(out, plaintext, keystream)
+
+	s.f = f
+	s.e ^= 1
+	return ret
+}
+
+// Open decrypts and authenticates the next message of the session, and
+// appends the result to dst. If authentication fails, the session can no
+// longer be used. The ciphertext and dst must overlap exactly or not at
+// all.
+func (s *KravatteSANSE) Open(dst, ciphertext, additionalData []byte) ([]byte, error) {
+	if s.failed {
+		return nil, errKravatteFailed
+	}
+	if len(ciphertext) < kravatteTagSize {
+		s.failed = true
+		return nil, errOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-kravatteTagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-kravatteTagSize]
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if inexactOverlap(out, ciphertext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	if len(additionalData) > 0 || len(ciphertext) == 0 {
+		s.f.addString(additionalData, s.e<<1, 2)
+	}
+	var expected [kravatteTagSize]byte
+	if len(ciphertext) > 0 {
+		keystream := make([]byte, len(ciphertext))
+		s.keystream(keystream, tag)
+		subtle.XORBytes(out, ciphertext, keystream)
+		s.f.addString(out, 0b10|s.e<<2, 3)
+	}
+	s.f.expand(expected[:], 0)
+
+	if subtle.ConstantTimeCompare(expected[:], tag) != 1 {
+		clear(out)
+		s.failed = true
+		return nil, errOpen
+	}
+	s.e ^= 1
+	return ret, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// The vectors are generated by _gen/kravatte.py, a transcription of Farfalle
+// and of the rolling functions of Kravatte Achouffe. The key is
+// 00 01 02 ... 1f, the nonce 40 41 42 ... 4f, and long outputs are given as
+// their Keccak-256 digest. With the keccak_xkcp tag, the tests of
+// kravatte_xkcp_test.go also check Kravatte against XKCP.
+
+func kravatteTestKey() (key, nonce []byte) {
+	return ketjeTestKey(32, 16)
+}
+
+func TestKravatte(t *testing.T) {
+	key, _ := kravatteTestKey()
+
+	f := newKravatte(key)
+	out := make([]byte, 16)
+	f.expand(out, 0)
+	if got, want := hex.EncodeToString(out), "1f1e601636fbca5dea4dbd8161701ee6"; got != want {
+		t.Errorf("empty input: got %s, want %s", got, want)
+	}
+
+	f.addString(ptn(500), 0, 0)
+	out = make([]byte, 400)
+	f.expand(out, 0)
+	if got, want := keyakDigest(out), "4c561f582b729e56df68892021ddc631bd1a06a14fe62fed17bbe8c05b5b5947"; got != want {
+		t.Errorf("ptn(500): got %s, want %s", got, want)
+	}
+
+	// Expanding with an offset skips the start of the same output.
+	for _, offset := range []int{1, 200, 250} {
+		skipped := make([]byte, 400-offset)
+		f.expand(skipped, offset)
+		if !bytes.Equal(skipped, out[offset:]) {
+			t.Errorf("expand with offset %d differs", offset)
+		}
+	}
+}
+
+// kravatteChainedLengths are around the 200-byte block of Kravatte, so that
+// one or two strings of these lengths take from 2 to 10 rolls of the mask.
+var kravatteChainedLengths = []int{0, 1, 198, 199, 200, 201, 399, 400, 401, 601}
+
+func TestKravatteChained(t *testing.T) {
+	key, _ := kravatteTestKey()
+	check := NewShake128()
+	out := make([]byte, 450)
+	for _, a := range kravatteChainedLengths {
+		f := newKravatte(key)
+		f.addString(ptn(a), 0, 0)
+		f.expand(out, 0)
+		check.Write(out)
+	}
+	for _, a := range kravatteChainedLengths {
+		for _, b := range kravatteChainedLengths {
+			f := newKravatte(key)
+			f.addString(ptn(a), 0, 0)
+			f.addString(ptn(b), 0b1, 1)
+			f.expand(out, 0)
+			check.Write(out)
+		}
+	}
+	got := make([]byte, 32)
+	check.Read(got)
+	if want := "cb4ea8c8724076792687542a91dbdaf0eab75bb9b1f3694867cdaf856a2aacc6"; hex.EncodeToString(got) != want {
+		t.Errorf("chained check = %x, want %s", got, want)
+	}
+}
+
+var kravatteSessionMessages = []struct{ msg, ad []byte }{
+	{ptn(10), []byte("ad1")},
+	{nil, ptn(300)},
+	{ptn(500), nil},
+	{nil, nil},
+}
+
+func TestKravatteSANE(t *testing.T) {
+	key, nonce := kravatteTestKey()
+	want := []string{
+		"f570be9e18767ac34c445fd50d457cf9dcc5a93b0ae443683efba33c54d7e887",
+		"08dfe505f4626df1e4a9e5e128f8a443",
+		"4c975660d0360e89343604de0c797391c62c2920f427b994e4d46496655ae572",
+		"40472f3a7f36f7711426c03f8c883d64",
+	}
+
+	sealer, err := NewKravatteSANE(key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opener, _ := NewKravatteSANE(key, nonce)
+	if got := hex.EncodeToString(sealer.Tag()); got != "5c7f628ff1c4c57157be2312cb7cfd01" {
+		t.Errorf("initial tag = %s", got)
+	}
+	for i, m := range kravatteSessionMessages {
+		ct := sealer.Seal(nil, m.msg, m.ad)
+		if got := keyakDigest(ct); got != want[i] {
+			t.Errorf("message %d: got %s, want %s", i, got, want[i])
+		}
+		pt, err := opener.Open(nil, ct, m.ad)
+		if err != nil || !bytes.Equal(pt, m.msg) {
+			t.Errorf("message %d: Open failed: %v", i, err)
+		}
+	}
+}
+
+func TestKravatteSANSE(t *testing.T) {
+	key, _ := kravatteTestKey()
+	want := []string{
+		"a304307eaecd5dda72e4b653bde050cf2bfe9f40f2e85ff9eb9f786f28ef56e0",
+		"d7c93c3dab211aefe8d3e3b368ebc2e4",
+		"82de72994096677879e1b0f2ab8487023ddffefba08ba95623f16f633b12089a",
+		"ddb2c7d359ddf4cb0aa1062aeec73712",
+	}
+
+	sealer, err := NewKravatteSANSE(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opener, _ := NewKravatteSANSE(key)
+	for i, m := range kravatteSessionMessages {
+		ct := sealer.Seal(nil, m.msg, m.ad)
+		if got := keyakDigest(ct); got != want[i] {
+			t.Errorf("message %d: got %s, want %s", i, got, want[i])
+		}
+		pt, err := opener.Open(nil, ct, m.ad)
+		if err != nil || !bytes.Equal(pt, m.msg) {
+			t.Errorf("message %d: Open failed: %v", i, err)
+		}
+	}
+}
+
+func TestKravatteTamper(t *testing.T) {
+	key, nonce := kravatteTestKey()
+	type session interface {
+		Seal(dst, plaintext, additionalData []byte) []byte
+		Open(dst, ciphertext, additionalData []byte) ([]byte, error)
+	}
+	for _, mode := range []struct {
+		name string
+		new  func() session
+	}{
+		{"SANE", func() session { s, _ := NewKravatteSANE(key, nonce); return s }},
+		{"SANSE", func() session { s, _ := NewKravatteSANSE(key); return s }},
+	} {
+		ct := mode.new().Seal(nil, ptn(300), []byte("header"))
+		for i := range ct {
+			bad := bytes.Clone(ct)
+			bad[i] ^= 0x01
+			if _, err := mode.new().Open(nil, bad, []byte("header")); err == nil {
+				t.Fatalf("%s: Open accepted a ciphertext modified at byte %d", mode.name, i)
+			}
+		}
+		opener := mode.new()
+		if _, err := opener.Open(nil, ct, []byte("headex")); err == nil {
+			t.Errorf("%s: Open accepted modified associated data", mode.name)
+		}
+		if _, err := opener.Open(nil, ct, []byte("header")); err != errKravatteFailed {
+			t.Errorf("%s: Open after a failure returned %v", mode.name, err)
+		}
+
+		buf := bytes.Clone(ct)
+		if pt, err := mode.new().Open(buf[:0], buf, []byte("header")); err != nil || !bytes.Equal(pt, ptn(300)) {
+			t.Errorf("%s: in-place Open failed: %v", mode.name, err)
+		}
+	}
+}
+
+func TestKravatteSessionChained(t *testing.T) {
+	key, nonce := kravatteTestKey()
+	sane, err := NewKravatteSANE(key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sanse, err := NewKravatteSANSE(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mode := range []struct {
+		name string
+		seal func(plaintext, additionalData []byte) []byte
+		want string
+	}{
+		{"SANE", func(p, ad []byte) []byte { return sane.Seal(nil, p, ad) },
+			"ff020c71e6842f1bba34ab54933d781d9dcccda5519ef14c7956144e1ce22b12"},
+		{"SANSE", func(p, ad []byte) []byte { return sanse.Seal(nil, p, ad) },
+			"0f096559a8f969eb65664bcafda9c64b9044819891f110f2c73bbe719aa9a6d6"},
+	} {
+		check := NewShake128()
+		for _, adLen := range kravatteChainedLengths {
+			for _, msgLen := range kravatteChainedLengths {
+				check.Write(mode.seal(ptn(msgLen), ptn(adLen)))
+			}
+		}
+		got := make([]byte, 32)
+		check.Read(got)
+		if hex.EncodeToString(got) != mode.want {
+			t.Errorf("%s: chained check = %x, want %s", mode.name, got, mode.want)
+		}
+	}
+}
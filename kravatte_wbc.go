@@ -0,0 +1,85 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "crypto/subtle"
+
+// KravatteWBC is Kravatte-WBC, a tweakable wide block cipher that
+// encrypts strings of any length to strings of the same length. It is the
+// Farfalle-WBC mode over Kravatte: a four-round Feistel network on the two
+// halves of the input, whose outer rounds only mask the first block of
+// each half and whose inner rounds also depend on the tweak.
+//
+// Since the cipher is deterministic, equal inputs under the same tweak
+// give equal outputs, and inputs of a few bytes offer little security.
+type KravatteWBC struct {
+	f kravatte
+}
+
+// NewKravatteWBC returns a Kravatte-WBC instance. The key must be between
+// 16 and 199 bytes long.
+func NewKravatteWBC(key []byte) (*KravatteWBC, error) {
+	if err := checkKravatteKey(key); err != nil {
+		return nil, err
+	}
+	return &KravatteWBC{f: newKravatte(key)}, nil
+}
+
+// h is the function of the outer rounds. It masks up to one block of y
+// with the output for x.
+func (c *KravatteWBC) h(y, x, buf []byte) {
+	f := c.f
+	f.addString(x, 0, 1)
+	mask := buf[:min(len(y), kravatteBlockSize)]
+	f.expand(mask, 0)
+	subtle.XORBytes(y, y, mask)
+}
+
+// g is the function of the inner rounds. It masks y with the output for
+// the tweak followed by x.
+func (c *KravatteWBC) g(y, tweak, x, buf []byte) {
+	f := c.f
+	f.addString(tweak, 0, 0)
+	f.addString(x, 1, 1)
+	mask := buf[:len(y)]
+	f.expand(mask, 0)
+	subtle.XORBytes(y, y, mask)
+}
+
+// halves copies src to dst and returns the two halves of the result, and
+// a buffer large enough for the masks.
+func (c *KravatteWBC) halves(dst, src []byte) (l, r, buf []byte) {
+	if len(dst) < len(src) {
+		panic("keccak: output smaller than input")
+	}
+	if inexactOverlap(dst[:len(src)], src) {
+		panic("keccak: invalid buffer overlap")
+	}
+	copy(dst, src)
+	n := len(src) / 2
+	return dst[:n], dst[n:len(src)], make([]byte, len(src)-n)
+}
+
+// Encrypt encrypts src with the given tweak into dst. Dst and src must
+// overlap entirely or not at all, and dst must be at least as long as
+// src.
+func (c *KravatteWBC) Encrypt(dst, src, tweak []byte) {
+	l, r, buf := c.halves(dst, src)
+	c.h(r, l, buf)
+	c.g(l, tweak, r, buf)
+	c.g(r, tweak, l, buf)
+	c.h(l, r, buf)
+}
+
+// Decrypt decrypts src with the given tweak into dst. Dst and src must
+// overlap entirely or not at all, and dst must be at least as long as
+// src.
+func (c *KravatteWBC) Decrypt(dst, src, tweak []byte) {
+	l, r, buf := c.halves(dst, src)
+	c.h(l, r, buf)
+	c.g(r, tweak, l, buf)
+	c.g(l, tweak, r, buf)
+	c.h(r, l, buf)
+}
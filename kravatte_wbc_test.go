@@ -0,0 +1,92 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// The vectors are generated by _gen/kravatte_wbc.py, a transcription of
+// Farfalle-WBC over Kravatte. The key is 00 01 02 ... 1f, and long outputs
+// are given as their Keccak-256 digest. With the keccak_xkcp tag,
+// TestKravatteWBCMatchesXKCP also checks Kravatte-WBC against XKCP.
+var kravatteWBCVectors = []struct {
+	msgLen, tweakLen int
+	want             string
+}{
+	{1, 0, "3b"},
+	{16, 0, "42a2a8274688793cc286aeccfd18105d"},
+	{16, 5, "2beee052834115bdeb8d264276bf82ad"},
+	{1000, 16, "aef0b0c95198cdf8efb38a539b2ea069632b5175fd732408f1c9a7f5410f21e4"},
+	{4096, 16, "a3749a3ad4a4f3c49211fb66feb88fd9ba4f0610980ca1d75cefea97cf25771a"},
+}
+
+func TestKravatteWBC(t *testing.T) {
+	key, _ := kravatteTestKey()
+	c, err := NewKravatteWBC(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range kravatteWBCVectors {
+		msg, tweak := ptn(v.msgLen), ptn(v.tweakLen)
+		ct := make([]byte, len(msg))
+		c.Encrypt(ct, msg, tweak)
+		if got := keyakDigest(ct); got != v.want {
+			t.Errorf("msg=%d tweak=%d: got %s, want %s", v.msgLen, v.tweakLen, got, v.want)
+		}
+
+		// Decrypt in place.
+		c.Decrypt(ct, ct, tweak)
+		if !bytes.Equal(ct, msg) {
+			t.Errorf("msg=%d tweak=%d: Decrypt did not invert Encrypt", v.msgLen, v.tweakLen)
+		}
+	}
+}
+
+func TestKravatteWBCChained(t *testing.T) {
+	// The halves of these lengths are around one and two blocks.
+	lengths := []int{1, 2, 3, 199, 200, 201, 399, 400, 401, 402, 799, 800, 801}
+	key, _ := kravatteTestKey()
+	c, _ := NewKravatteWBC(key)
+	check := NewShake128()
+	for _, tweakLen := range []int{0, 1, 200} {
+		for _, msgLen := range lengths {
+			ct := make([]byte, msgLen)
+			c.Encrypt(ct, ptn(msgLen), ptn(tweakLen))
+			check.Write(ct)
+		}
+	}
+	got := make([]byte, 32)
+	check.Read(got)
+	if want := "03528f33051adb0ff8da883318f28d4d83ccc0c05188fbcbf909730dbaeabf1e"; hex.EncodeToString(got) != want {
+		t.Errorf("chained check = %x, want %s", got, want)
+	}
+}
+
+func TestKravatteWBCDiffusion(t *testing.T) {
+	// Changing any byte of the input, or the tweak, changes both halves
+	// of the output.
+	key, _ := kravatteTestKey()
+	c, _ := NewKravatteWBC(key)
+	msg := ptn(600)
+	want := make([]byte, len(msg))
+	c.Encrypt(want, msg, nil)
+
+	got := make([]byte, len(msg))
+	for _, i := range []int{0, 299, 300, 599} {
+		modified := bytes.Clone(msg)
+		modified[i] ^= 1
+		c.Encrypt(got, modified, nil)
+		if bytes.Equal(got[:300], want[:300]) || bytes.Equal(got[300:], want[300:]) {
+			t.Errorf("changing byte %d left half of the output unchanged", i)
+		}
+	}
+	c.Encrypt(got, msg, []byte{0})
+	if bytes.Equal(got[:300], want[:300]) || bytes.Equal(got[300:], want[300:]) {
+		t.Error("changing the tweak left half of the output unchanged")
+	}
+}
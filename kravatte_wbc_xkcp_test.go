@@ -0,0 +1,38 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/filecoin-project/go-keccak/internal/xkcp"
+)
+
+// TestKravatteWBCMatchesXKCP checks Kravatte-WBC against XKCP, the Keccak
+// team's implementation, with halves around one and two blocks, under
+// tweaks of several lengths.
+func TestKravatteWBCMatchesXKCP(t *testing.T) {
+	key, _ := kravatteTestKey()
+	c, err := NewKravatteWBC(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := xkcp.NewKravatteWBC(key)
+	defer x.Close()
+
+	for _, tweakLen := range []int{0, 1, 200} {
+		for _, msgLen := range []int{1, 2, 3, 16, 199, 200, 201, 399, 400, 401, 402, 799, 800, 801, 4096} {
+			msg, tweak := ptn(msgLen), ptn(tweakLen)
+			got := make([]byte, msgLen)
+			c.Encrypt(got, msg, tweak)
+			if want := x.Encrypt(msg, tweak); !bytes.Equal(got, want) {
+				t.Errorf("msg=%d tweak=%d: got %x, XKCP %x", msgLen, tweakLen, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/filecoin-project/go-keccak/internal/xkcp"
+)
+
+// TestKravatteMatchesXKCP checks the Kravatte deck function against XKCP,
+// the Keccak team's implementation, on sequences of one and two strings of
+// lengths around the block size, followed by suffixes of up to three bits.
+func TestKravatteMatchesXKCP(t *testing.T) {
+	key, _ := kravatteTestKey()
+	x := xkcp.NewKravatte(key)
+	defer x.Close()
+
+	suffixes := []struct {
+		suffix byte
+		bits   int
+	}{{0, 0}, {0b1, 1}, {0b10, 2}, {0b110, 3}}
+	for _, a := range kravatteChainedLengths {
+		f := newKravatte(key)
+		f.addString(ptn(a), 0, 0)
+		got := make([]byte, 450)
+		f.expand(got, 0)
+		x.Compress(ptn(a), 0, 0, true)
+		if want := x.Expand(len(got)); !bytes.Equal(got, want) {
+			t.Errorf("string of %d bytes: got %x, XKCP %x", a, got, want)
+		}
+
+		for _, b := range kravatteChainedLengths {
+			for _, s := range suffixes {
+				f := newKravatte(key)
+				f.addString(ptn(a), s.suffix, s.bits)
+				f.addString(ptn(b), s.suffix, s.bits)
+				f.expand(got, 0)
+				x.Compress(ptn(a), s.suffix, s.bits, true)
+				x.Compress(ptn(b), s.suffix, s.bits, false)
+				if want := x.Expand(len(got)); !bytes.Equal(got, want) {
+					t.Fatalf("strings of %d and %d bytes with %d suffix bits: got %x, XKCP %x", a, b, s.bits, got, want)
+				}
+			}
+		}
+	}
+}
+
+// TestKravatteSessionsMatchXKCP checks Kravatte-SANE and Kravatte-SANSE
+// against XKCP, sealing every pair of lengths around the block size in one
+// session.
+func TestKravatteSessionsMatchXKCP(t *testing.T) {
+	key, nonce := kravatteTestKey()
+	sane, err := NewKravatteSANE(key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xSANE, tag := xkcp.NewKravatteSANE(key, nonce)
+	defer xSANE.Close()
+	if !bytes.Equal(sane.Tag(), tag) {
+		t.Errorf("SANE: initial tag = %x, XKCP %x", sane.Tag(), tag)
+	}
+	sanse, err := NewKravatteSANSE(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xSANSE := xkcp.NewKravatteSANSE(key)
+	defer xSANSE.Close()
+
+	for _, mode := range []struct {
+		name       string
+		seal, want func(plaintext, additionalData []byte) []byte
+	}{
+		{"SANE", func(p, ad []byte) []byte { return sane.Seal(nil, p, ad) }, xSANE.Seal},
+		{"SANSE", func(p, ad []byte) []byte { return sanse.Seal(nil, p, ad) }, xSANSE.Seal},
+	} {
+		for _, adLen := range kravatteChainedLengths {
+			for _, msgLen := range kravatteChainedLengths {
+				ad, msg := ptn(adLen), ptn(msgLen)
+				got, want := mode.seal(msg, ad), mode.want(msg, ad)
+				if !bytes.Equal(got, want) {
+					t.Fatalf("%s: ad=%d msg=%d: got %x, XKCP %x", mode.name, adLen, msgLen, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "io"
+
+// largeReadSize is the size of the buffers HashLargeReader reads into. It
+// holds enough leaves to keep every core busy.
+const largeReadSize = 512 * k12ChunkSize
+
+// LargeHashParams records how HashLargeBytes or HashLargeReader hashed its
+// input, so that the digest can be recomputed and checked by other
+// implementations.
+type LargeHashParams struct {
+	// Function names the tree hash used. It is "KT128": KangarooTwelve as
+	// specified in RFC 9861, with an empty customization string and 32
+	// bytes of output, as computed by NewKangarooTwelve(nil).
+	Function string
+
+	// ChunkSize is the size in bytes of the leaves of the tree, which are
+	// hashed concurrently.
+	ChunkSize int
+
+	// Length is the number of bytes hashed.
+	Length int64
+}
+
+// HashLargeBytes hashes data with a tree hash whose leaves are hashed
+// concurrently on up to GOMAXPROCS goroutines, and returns the digest and
+// the parameters used. A tree hash has a different digest from a sequential
+// one, so it is only suitable where both sides agree on the parameters.
+func HashLargeBytes(data []byte) ([32]byte, LargeHashParams) {
+	h := NewKangarooTwelve(nil)
+	h.Write(data)
+	var digest [32]byte
+	h.Read(digest[:])
+	return digest, largeHashParams(int64(len(data)))
+}
+
+// HashLargeReader is like HashLargeBytes, but hashes everything read from r
+// until EOF. Reading from r overlaps with hashing what was read before.
+func HashLargeReader(r io.Reader) ([32]byte, LargeHashParams, error) {
+	h := NewKangarooTwelve(nil)
+	var length int64
+	bufs := [2][]byte{make([]byte, largeReadSize), make([]byte, largeReadSize)}
+
+	// Each buffer read is hashed on another goroutine while the next one is
+	// read into the other buffer.
+	var done chan struct{}
+	for i := 0; ; i ^= 1 {
+		n, err := io.ReadFull(r, bufs[i])
+		if done != nil {
+			<-done
+			done = nil
+		}
+		if n > 0 {
+			length += int64(n)
+			done = make(chan struct{})
+			go func(p []byte, done chan struct{}) {
+				h.Write(p)
+				close(done)
+			}(bufs[i][:n], done)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			if done != nil {
+				<-done
+			}
+			return [32]byte{}, LargeHashParams{}, err
+		}
+	}
+	if done != nil {
+		<-done
+	}
+
+	var digest [32]byte
+	h.Read(digest[:])
+	return digest, largeHashParams(length), nil
+}
+
+func largeHashParams(length int64) LargeHashParams {
+	return LargeHashParams{Function: "KT128", ChunkSize: k12ChunkSize, Length: length}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestHashLarge(t *testing.T) {
+	for _, n := range []int{0, 1, k12ChunkSize + 1, largeReadSize, 2*largeReadSize + 12345} {
+		data := ptn(n)
+		h := NewKangarooTwelve(nil)
+		h.Write(data)
+		var want [32]byte
+		h.Read(want[:])
+		wantParams := LargeHashParams{Function: "KT128", ChunkSize: 8192, Length: int64(n)}
+
+		digest, params := HashLargeBytes(data)
+		if digest != want || params != wantParams {
+			t.Errorf("HashLargeBytes(%d bytes) = %x, %+v, want %x, %+v", n, digest, params, want, wantParams)
+		}
+
+		// Short reads must not change the digest.
+		for _, r := range []io.Reader{bytes.NewReader(data), iotest.HalfReader(bytes.NewReader(data))} {
+			digest, params, err := HashLargeReader(r)
+			if err != nil || digest != want || params != wantParams {
+				t.Errorf("HashLargeReader(%d bytes) = %x, %+v, %v, want %x, %+v", n, digest, params, err, want, wantParams)
+			}
+		}
+	}
+}
+
+func TestHashLargeReaderError(t *testing.T) {
+	errRead := errors.New("read failed")
+	r := io.MultiReader(bytes.NewReader(ptn(largeReadSize+100)), iotest.ErrReader(errRead))
+	if _, _, err := HashLargeReader(r); err != errRead {
+		t.Errorf("HashLargeReader error = %v, want %v", err, errRead)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides a lightweight hash profile over Keccak-f[800] for
+// 32-bit microcontrollers and TinyGo targets, where the 32-bit lanes of
+// Keccak-f[800] avoid the cost of emulating 64-bit rotations and the state
+// takes half the memory of Keccak-f[1600]. The profile fixes a rate of 544
+// bits and a capacity of 256 bits, for a generic security strength of 128
+// bits, and reuses the SHA-3 and SHAKE domain separation so that the
+// functions mirror SHA3-256 and SHAKE128.
+
+import "hash"
+
+// rateLite is the rate of the lightweight profile, in bytes.
+const rateLite = (800 - 256) / 8
+
+// NewLite256 creates a new Lite-256 hash, the SHA3-256 analogue over
+// Keccak-f[800]. It returns 32 bytes of output, but its generic security
+// strength is 128 bits against both preimage and collision attacks.
+//
+// The state of a Lite-256 hash cannot be marshaled.
+func NewLite256() hash.Hash {
+	return newState(state{rate: rateLite, outputLen: 32, dsbyte: dsbyteSHA3, width: 100})
+}
+
+// NewLiteShake128 creates a new ShakeHash computing LiteSHAKE128, the
+// SHAKE128 analogue over Keccak-f[800]. Its generic security strength is
+// 128 bits; Sum returns 32 bytes of output.
+//
+// The state of a LiteSHAKE128 hash cannot be marshaled.
+func NewLiteShake128() ShakeHash {
+	return newState(state{rate: rateLite, outputLen: 32, dsbyte: dsbyteShake, width: 100})
+}
+
+// SumLite256 returns the Lite-256 digest of the data.
+func SumLite256(data []byte) (digest [32]byte) {
+	d := state{rate: rateLite, outputLen: 32, dsbyte: dsbyteSHA3, width: 100}
+	d.Write(data)
+	d.Read(digest[:])
+	return
+}
+
+// ShakeSumLite128 writes an arbitrary-length LiteSHAKE128 digest of data
+// into hash.
+func ShakeSumLite128(hash, data []byte) {
+	d := state{rate: rateLite, outputLen: 32, dsbyte: dsbyteShake, width: 100}
+	d.Write(data)
+	d.Read(hash)
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors generated by _gen/lite.py.
+func TestLiteVectors(t *testing.T) {
+	for _, tc := range []struct {
+		msg        []byte
+		sum, shake string
+	}{
+		{
+			nil,
+			"564dccbb0776c09dd2d8bbef8948b8054db9aa76878aee83faed70496e830a57",
+			"986a73b04754b8e7a98bbd98eb5d827a99ed4a96b97e4abc5810502c69403a877362206fedec0903c4259b4cce0e29a199b534ec52d2099971075053be9ba99d",
+		},
+		{
+			[]byte("abc"),
+			"83d1e652dd8240071f38e03499500fe4fc3e74ddbcc6b5f6a35bae8bbf0a49eb",
+			"76deb8ff73e9d472d7b5895f5b97e938a5feb7be187db20d3869e47ac9484ac4e5345694d8bac276ad99c0418d39ce703ba05d8d37478f990539dedf819642ae",
+		},
+		{
+			ptn(200),
+			"42ae2fb6fdd8b04b49c8d1734a453597aa5cfe8ad78b1a4a73cd881b52b263a0",
+			"702c172ae3d6dd28191b1d2accb7fd7aa2fa54630588e3c24db2569b82ed4c29651183bc51f4aa3b2b594b6aea2bb26081f33f5762884f955c0a02fb243f6885",
+		},
+	} {
+		h := NewLite256()
+		h.Write(tc.msg)
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.sum {
+			t.Errorf("Lite-256(%d bytes) = %s, want %s", len(tc.msg), got, tc.sum)
+		}
+		if sum := SumLite256(tc.msg); hex.EncodeToString(sum[:]) != tc.sum {
+			t.Errorf("SumLite256(%d bytes) = %x, want %s", len(tc.msg), sum, tc.sum)
+		}
+
+		x := NewLiteShake128()
+		x.Write(tc.msg)
+		out := make([]byte, 64)
+		x.Read(out)
+		if got := hex.EncodeToString(out); got != tc.shake {
+			t.Errorf("LiteSHAKE128(%d bytes) = %s, want %s", len(tc.msg), got, tc.shake)
+		}
+		ShakeSumLite128(out, tc.msg)
+		if got := hex.EncodeToString(out); got != tc.shake {
+			t.Errorf("ShakeSumLite128(%d bytes) = %s, want %s", len(tc.msg), got, tc.shake)
+		}
+	}
+}
+
+func TestSumLite256Allocations(t *testing.T) {
+	data := ptn(100)
+	if n := testing.AllocsPerRun(10, func() { SumLite256(data) }); n > 0 {
+		t.Errorf("SumLite256 allocated %v times", n)
+	}
+}
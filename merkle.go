@@ -0,0 +1,121 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/binary"
+	"runtime"
+	"sync"
+)
+
+// HashPair returns the legacy Keccak-256 digest of a followed by b, which
+// is the hash of an interior node of a Merkle tree over its two children.
+// It equals Sum256 of the 64 bytes, but loads the lanes of the single
+// padded block straight from a and b and permutes them once.
+func HashPair(a, b [32]byte) [32]byte {
+	if useKIMD {
+		var buf [64]byte
+		copy(buf[:], a[:])
+		copy(buf[32:], b[:])
+		return sumBlock256(buf[:])
+	}
+
+	var s [25]uint64
+	for i := range 4 {
+		s[i] = binary.LittleEndian.Uint64(a[8*i:])
+		s[4+i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	s[8] = dsbyteKeccak
+	s[rateK512/8-1] = 0x80 << 56
+	return permuteBlock256(&s, 64)
+}
+
+// HashLevel returns the level of a Merkle tree above nodes: the HashPair of
+// each pair of consecutive nodes, in order. If the number of nodes is odd,
+// the last one has no sibling and is carried up to the next level
+// unchanged.
+//
+// Where the CPU supports it, the pairs are hashed several at a time with
+// SIMD instructions, as HashBatch256 does. Large levels are also spread over
+// up to GOMAXPROCS goroutines.
+func HashLevel(nodes [][32]byte) [][32]byte {
+	pairs := len(nodes) / 2
+	level := make([][32]byte, (len(nodes)+1)/2)
+	if len(nodes)%2 == 1 {
+		level[pairs] = nodes[len(nodes)-1]
+	}
+	nodes, parents := nodes[:2*pairs], level[:pairs]
+
+	workers := min(runtime.GOMAXPROCS(0), pairs)
+	if 64*pairs < parallelThreshold || workers < 2 {
+		hashPairs(nodes, parents)
+		return level
+	}
+
+	var wg sync.WaitGroup
+	per := lineRun((pairs+workers-1)/workers, 32)
+	for lo := 0; lo < pairs; lo += per {
+		hi := min(lo+per, pairs)
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			hashPairs(nodes[2*lo:2*hi], parents[lo:hi])
+		}(lo, hi)
+	}
+	wg.Wait()
+	return level
+}
+
+// hashPairs writes the HashPair of each pair of nodes to parents, with the
+// widest multi-buffer permutation that is faster than the single-state one.
+func hashPairs(nodes, parents [][32]byte) {
+	switch {
+	case fastX8:
+		hashPairsMulti(keccakF1600x8, 1, nodes, parents)
+	case fastX4:
+		hashPairsMulti(keccakF1600x4, 2, nodes, parents)
+	case fastX2:
+		hashPairsMulti(keccakF1600x2, 1, nodes, parents)
+	default:
+		for i := range parents {
+			parents[i] = HashPair(nodes[2*i], nodes[2*i+1])
+		}
+	}
+}
+
+// hashPairsMulti is hashPairs with the states permuted together by permute.
+// As every pair is a single padded block, the states are loaded with a
+// group of pairs, permuted once and read back, with no scheduling. The last
+// pairs are hashed one at a time if there are at most alone of them.
+func hashPairsMulti[L multiLanes](permute func(*[25]L), alone int, nodes, parents [][32]byte) {
+	var a [25]L
+	lanes := len(a[0])
+	for len(parents) > alone {
+		n := min(lanes, len(parents))
+		a = [25]L{}
+		for j := range n {
+			x, y := &nodes[2*j], &nodes[2*j+1]
+			for i := range 4 {
+				a[i][j] = binary.LittleEndian.Uint64(x[8*i:])
+				a[4+i][j] = binary.LittleEndian.Uint64(y[8*i:])
+			}
+			a[8][j] = dsbyteKeccak
+			a[rateK512/8-1][j] = 0x80 << 56
+		}
+		permute(&a)
+		for j := range n {
+			for i := range 4 {
+				binary.LittleEndian.PutUint64(parents[j][8*i:], a[i][j])
+			}
+		}
+		countPermutations(n)
+		countAbsorbed(64 * n)
+		countSqueezed(32 * n)
+		nodes, parents = nodes[2*n:], parents[n:]
+	}
+	for i := range parents {
+		parents[i] = HashPair(nodes[2*i], nodes[2*i+1])
+	}
+}
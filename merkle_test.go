@@ -0,0 +1,92 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "testing"
+
+func TestHashPair(t *testing.T) {
+	var a, b [32]byte
+	msg := ptn(84)
+	for i := range 20 {
+		copy(a[:], msg[i:])
+		copy(b[:], msg[32+i:])
+		if got, want := HashPair(a, b), Sum256(append(a[:], b[:]...)); got != want {
+			t.Errorf("HashPair(%x, %x) = %x, want %x", a, b, got, want)
+		}
+	}
+	if n := testing.AllocsPerRun(10, func() { HashPair(a, b) }); n > 0 {
+		t.Errorf("HashPair allocated %v times, want 0", n)
+	}
+}
+
+func BenchmarkHashPair(b *testing.B) {
+	var x, y [32]byte
+	b.SetBytes(64)
+	for i := 0; i < b.N; i++ {
+		x = HashPair(x, y)
+	}
+}
+
+func TestHashLevel(t *testing.T) {
+	// The largest level is hashed concurrently.
+	for _, count := range []int{0, 1, 2, 3, 9, 16, 17, 31, 4001} {
+		nodes := make([][32]byte, count)
+		msg := ptn(count + 32)
+		for i := range nodes {
+			copy(nodes[i][:], msg[i:])
+		}
+		got := HashLevel(nodes)
+		if len(got) != (count+1)/2 {
+			t.Fatalf("HashLevel of %d nodes returned %d nodes", count, len(got))
+		}
+		for i := range count / 2 {
+			if want := HashPair(nodes[2*i], nodes[2*i+1]); got[i] != want {
+				t.Errorf("%d nodes: parent %d = %x, want %x", count, i, got[i], want)
+			}
+		}
+		if count%2 == 1 && got[count/2] != nodes[count-1] {
+			t.Errorf("%d nodes: last node %x was not carried up, got %x", count, nodes[count-1], got[count/2])
+		}
+	}
+}
+
+func TestHashPairsMulti(t *testing.T) {
+	nodes := make([][32]byte, 2*13)
+	msg := ptn(len(nodes) + 32)
+	for i := range nodes {
+		copy(nodes[i][:], msg[i:])
+	}
+	want := make([][32]byte, len(nodes)/2)
+	for i := range want {
+		want[i] = HashPair(nodes[2*i], nodes[2*i+1])
+	}
+	for _, tc := range []struct {
+		name  string
+		lanes int
+		hash  func(alone int, parents [][32]byte)
+	}{
+		{"x2", 2, func(alone int, parents [][32]byte) { hashPairsMulti(keccakF1600x2, alone, nodes, parents) }},
+		{"x4", 4, func(alone int, parents [][32]byte) { hashPairsMulti(keccakF1600x4, alone, nodes, parents) }},
+		{"x8", 8, func(alone int, parents [][32]byte) { hashPairsMulti(keccakF1600x8, alone, nodes, parents) }},
+	} {
+		for alone := range tc.lanes {
+			got := make([][32]byte, len(want))
+			tc.hash(alone, got)
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("%s, alone %d: parent %d = %x, want %x", tc.name, alone, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkHashLevel(b *testing.B) {
+	nodes := make([][32]byte, 1<<14)
+	b.SetBytes(int64(32 * len(nodes)))
+	for i := 0; i < b.N; i++ {
+		HashLevel(nodes)
+	}
+}
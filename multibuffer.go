@@ -0,0 +1,125 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the permutations of several independent states at once
+// used by multi-buffer hashing. A group of L states is stored interleaved as
+// a *[25][L]uint64, where a[i][j] is lane i of state j, so that each lane of
+// all the states fills one SIMD register. sumMulti hashes a batch of
+// messages with them.
+
+import "encoding/binary"
+
+// multiLanes are the groups of interleaved states.
+type multiLanes interface {
+	[2]uint64 | [4]uint64 | [8]uint64
+}
+
+// keccakF1600Lanes applies Keccak-f[1600] to each of the interleaved states
+// of a in turn. It is the fallback for platforms without a SIMD
+// implementation for groups of that size.
+func keccakF1600Lanes[L multiLanes](a *[25]L) {
+	var s [25]uint64
+	for j := range len(a[0]) {
+		for i := range s {
+			s[i] = a[i][j]
+		}
+		keccakF1600(&s)
+		for i := range s {
+			a[i][j] = s[i]
+		}
+	}
+}
+
+// sumMulti hashes each of msgs with the sponge of the given rate and domain
+// separation byte, and writes the digests of outputLen bytes, which must be
+// at most the rate, one after the other to out.
+//
+// The messages are spread over the states permuted together by permute.
+// Each state takes the next pending message as soon as it is done with its
+// own, so that messages of different lengths keep all of them busy. Once
+// no message is pending and at most alone states are still busy, permuting
+// the idle states would cost more than finishing the busy ones one at a
+// time with the single-state permutation, which sumMulti then does.
+func sumMulti[L multiLanes](permute func(*[25]L), alone int, msgs [][]byte, rate int, dsbyte byte, outputLen int, out []byte) {
+	var a [25]L
+	lanes := len(a[0])
+
+	// msg[j] is the index of the message absorbed by state j, or -1 if the
+	// state is idle, and rest[j] is what remains to be absorbed of it.
+	// final[j] is set once the padded last block has been absorbed.
+	msg := make([]int, lanes)
+	rest := make([][]byte, lanes)
+	final := make([]bool, lanes)
+	next, active := 0, 0
+	load := func(j int) {
+		for i := range a {
+			a[i][j] = 0
+		}
+		msg[j], final[j] = -1, false
+		if next < len(msgs) {
+			msg[j], rest[j] = next, msgs[next]
+			next++
+			active++
+		}
+	}
+	for j := range lanes {
+		load(j)
+	}
+
+	var buf [200]byte
+	for active > 0 {
+		if next == len(msgs) && active <= alone {
+			for j := range lanes {
+				if msg[j] < 0 {
+					continue
+				}
+				d := state{rate: rate, dsbyte: dsbyte, outputLen: outputLen}
+				for i := range a {
+					binary.LittleEndian.PutUint64(d.a[8*i:], a[i][j])
+				}
+				d.Write(rest[j])
+				d.Read(out[msg[j]*outputLen : (msg[j]+1)*outputLen])
+			}
+			return
+		}
+
+		for j := range lanes {
+			if msg[j] < 0 {
+				continue
+			}
+			block := rest[j]
+			countAbsorbed(min(len(block), rate))
+			if len(block) >= rate {
+				block, rest[j] = block[:rate], block[rate:]
+			} else {
+				clear(buf[:rate])
+				copy(buf[:], block)
+				buf[len(block)] ^= dsbyte
+				buf[rate-1] ^= 0x80
+				block, rest[j], final[j] = buf[:rate], nil, true
+			}
+			for i := range rate / 8 {
+				a[i][j] ^= binary.LittleEndian.Uint64(block[8*i:])
+			}
+		}
+
+		permute(&a)
+		countPermutations(active)
+
+		for j := range lanes {
+			if !final[j] {
+				continue
+			}
+			countSqueezed(outputLen)
+			for i := range (outputLen + 7) / 8 {
+				binary.LittleEndian.PutUint64(buf[8*i:], a[i][j])
+			}
+			copy(out[msg[j]*outputLen:], buf[:outputLen])
+			active--
+			load(j)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+)
+
+// testMultiLanes checks that permute applies Keccak-f[1600] to each of the
+// interleaved states of a group.
+func testMultiLanes[L multiLanes](t *testing.T, name string, permute func(*[25]L)) {
+	var a [25]L
+	want := make([][25]uint64, len(a[0]))
+	for i := range a {
+		for j := range len(a[i]) {
+			a[i][j] = uint64(i+1)*0x9e3779b97f4a7c15 ^ uint64(j)<<56
+			want[j][i] = a[i][j]
+		}
+	}
+	for range 3 {
+		permute(&a)
+		for j := range want {
+			keccakP1600(&want[j], 24)
+		}
+	}
+	for j := range want {
+		for i := range a {
+			if a[i][j] != want[j][i] {
+				t.Fatalf("%s: state %d disagrees with the generic permutation", name, j)
+			}
+		}
+	}
+}
+
+func TestKeccakF1600x2(t *testing.T) {
+	testMultiLanes(t, "keccakF1600x2", keccakF1600x2)
+	testMultiLanes(t, "keccakF1600Lanes", keccakF1600Lanes[[2]uint64])
+}
+
+func BenchmarkKeccakF1600x2(b *testing.B) {
+	var a [25][2]uint64
+	b.SetBytes(2 * 200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600x2(&a)
+	}
+}
+
+func TestKeccakF1600x4(t *testing.T) {
+	testMultiLanes(t, "keccakF1600x4", keccakF1600x4)
+	testMultiLanes(t, "keccakF1600Lanes", keccakF1600Lanes[[4]uint64])
+}
+
+func BenchmarkKeccakF1600x4(b *testing.B) {
+	var a [25][4]uint64
+	b.SetBytes(4 * 200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600x4(&a)
+	}
+}
+
+func TestSumMulti(t *testing.T) {
+	// Messages around the block boundaries, of varied lengths so that the
+	// states take new messages at different times.
+	var msgs [][]byte
+	for _, n := range []int{0, 1, 135, 136, 137, 500, 271, 272, 7, 1000, 64, 0, 3} {
+		msgs = append(msgs, ptn(n))
+	}
+	for _, count := range []int{0, 1, 2, 3, 5, len(msgs)} {
+		want := make([]byte, 0, 32*count)
+		for _, m := range msgs[:count] {
+			h := NewLegacyKeccak256()
+			h.Write(m)
+			want = h.Sum(want)
+		}
+		got := make([]byte, 32*count)
+		for alone := range 2 {
+			sumMulti(keccakF1600x2, alone, msgs[:count], rateK512, dsbyteKeccak, 32, got)
+			if !bytes.Equal(got, want) {
+				t.Errorf("x2, alone %d: %d messages: got %x, want %x", alone, count, got, want)
+			}
+		}
+		for alone := range 4 {
+			clear(got)
+			sumMulti(keccakF1600x4, alone, msgs[:count], rateK512, dsbyteKeccak, 32, got)
+			if !bytes.Equal(got, want) {
+				t.Errorf("x4, alone %d: %d messages: got %x, want %x", alone, count, got, want)
+			}
+		}
+		for alone := range 8 {
+			clear(got)
+			sumMulti(keccakF1600x8, alone, msgs[:count], rateK512, dsbyteKeccak, 32, got)
+			if !bytes.Equal(got, want) {
+				t.Errorf("x8, alone %d: %d messages: got %x, want %x", alone, count, got, want)
+			}
+		}
+	}
+}
+
+func TestSumMultiOutputLength(t *testing.T) {
+	msgs := [][]byte{ptn(10), ptn(200), ptn(0)}
+	got := make([]byte, 28*len(msgs))
+	sumMulti(keccakF1600x4, 1, msgs, rateK448, dsbyteSHA3, 28, got)
+	for i, m := range msgs {
+		h := New224()
+		h.Write(m)
+		if want := h.Sum(nil); !bytes.Equal(got[28*i:28*(i+1)], want) {
+			t.Errorf("message %d: got %x, want %x", i, got[28*i:28*(i+1)], want)
+		}
+	}
+}
+
+func BenchmarkSumMulti(b *testing.B) {
+	msgs := make([][]byte, 64)
+	for i := range msgs {
+		msgs[i] = ptn(64)
+	}
+	out := make([]byte, 32*len(msgs))
+	b.Run("x1", func(b *testing.B) {
+		b.SetBytes(int64(64 * len(msgs)))
+		for i := 0; i < b.N; i++ {
+			for j, m := range msgs {
+				h := NewLegacyKeccak256()
+				h.Write(m)
+				h.Sum(out[32*j : 32*j])
+			}
+		}
+	})
+	b.Run("x2", func(b *testing.B) {
+		b.SetBytes(int64(64 * len(msgs)))
+		for i := 0; i < b.N; i++ {
+			sumMulti(keccakF1600x2, 1, msgs, rateK512, dsbyteKeccak, 32, out)
+		}
+	})
+	b.Run("x4", func(b *testing.B) {
+		b.SetBytes(int64(64 * len(msgs)))
+		for i := 0; i < b.N; i++ {
+			sumMulti(keccakF1600x4, 2, msgs, rateK512, dsbyteKeccak, 32, out)
+		}
+	})
+	b.Run("x8", func(b *testing.B) {
+		b.SetBytes(int64(64 * len(msgs)))
+		for i := 0; i < b.N; i++ {
+			sumMulti(keccakF1600x8, 1, msgs, rateK512, dsbyteKeccak, 32, out)
+		}
+	})
+}
+
+func TestKeccakF1600x8(t *testing.T) {
+	testMultiLanes(t, "keccakF1600x8", keccakF1600x8)
+	testMultiLanes(t, "keccakF1600Lanes", keccakF1600Lanes[[8]uint64])
+}
+
+func BenchmarkKeccakF1600x8(b *testing.B) {
+	var a [25][8]uint64
+	b.SetBytes(8 * 200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600x8(&a)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides sponge functions over the narrow Keccak-f[800],
+// Keccak-f[400] and Keccak-f[200] permutations. They use the same
+// multi-rate padding as the other functions in this package, and are
+// intended for constrained environments and for validating against the
+// Keccak reference code, which supports these widths as Keccak[r, c].
+
+func newNarrowSponge(width, rate, outputLen int, dsbyte byte) ShakeHash {
+	if rate <= 0 || rate >= width {
+		panic("keccak: sponge rate must be positive and less than the permutation width")
+	}
+	if outputLen <= 0 {
+		panic("keccak: invalid sponge output length")
+	}
+	if dsbyte < 0x01 || dsbyte > 0x7f {
+		panic("keccak: domain separation byte must be in the range 0x01-0x7F")
+	}
+	return newState(state{rate: rate, outputLen: outputLen, dsbyte: dsbyte, width: width})
+}
+
+// NewKeccakF800Sponge returns a sponge over Keccak-f[800] with the given
+// rate in bytes, which must be less than 100, and domain separation byte.
+// The capacity is 800 bits minus the rate; the generic security strength
+// is half the capacity. Sum returns outputLen bytes, and the output can be
+// read to an arbitrary length. Use a dsbyte of 0x01 for the original Keccak
+// padding.
+//
+// The state of a narrow sponge cannot be marshaled.
+func NewKeccakF800Sponge(rate, outputLen int, dsbyte byte) ShakeHash {
+	return newNarrowSponge(100, rate, outputLen, dsbyte)
+}
+
+// NewKeccakF400Sponge returns a sponge over Keccak-f[400] with the given
+// rate in bytes, which must be less than 50, and domain separation byte.
+// See NewKeccakF800Sponge for details.
+func NewKeccakF400Sponge(rate, outputLen int, dsbyte byte) ShakeHash {
+	return newNarrowSponge(50, rate, outputLen, dsbyte)
+}
+
+// NewKeccakF200Sponge returns a sponge over Keccak-f[200] with the given
+// rate in bytes, which must be less than 25, and domain separation byte.
+// See NewKeccakF800Sponge for details.
+func NewKeccakF200Sponge(rate, outputLen int, dsbyte byte) ShakeHash {
+	return newNarrowSponge(25, rate, outputLen, dsbyte)
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from KeccakF-800-IntermediateValues.txt and friends in the
+// Keccak reference package: the permutations applied to the zero state.
+
+func TestKeccakF800(t *testing.T) {
+	want := [25]uint32{
+		0xE531D45D, 0xF404C6FB, 0x23A0BF99, 0xF1F8452F, 0x51FFD042,
+		0xE539F578, 0xF00B80A7, 0xAF973664, 0xBF5AF34C, 0x227A2424,
+		0x88172715, 0x9F685884, 0xB15CD054, 0x1BF4FC0E, 0x6166FA91,
+		0x1A9E599A, 0xA3970A1F, 0xAB659687, 0xAFAB8D68, 0xE74B1015,
+		0x34001A98, 0x4119EFF3, 0x930A0E76, 0x87B28070, 0x11EFE996,
+	}
+	var a [25]uint32
+	KeccakF800(&a)
+	if a != want {
+		t.Errorf("KeccakF800 = %08X, want %08X", a, want)
+	}
+}
+
+func TestKeccakF400(t *testing.T) {
+	want := [25]uint16{
+		0x09F5, 0x40AC, 0x0FA9, 0x14F5, 0xE89F,
+		0xECA0, 0x5BD1, 0x7870, 0xEFF0, 0xBF8F,
+		0x0337, 0x6052, 0xDC75, 0x0EC9, 0xE776,
+		0x5246, 0x59A1, 0x5D81, 0x6D95, 0x6E14,
+		0x633E, 0x58EE, 0x71FF, 0x714C, 0xB38E,
+	}
+	var a [25]uint16
+	KeccakF400(&a)
+	if a != want {
+		t.Errorf("KeccakF400 = %04X, want %04X", a, want)
+	}
+}
+
+func TestKeccakF200(t *testing.T) {
+	want := [25]uint8{
+		0x3C, 0x28, 0x26, 0x84, 0x1C,
+		0xB3, 0x5C, 0x17, 0x1E, 0xAA,
+		0xE9, 0xB8, 0x11, 0x13, 0x4C,
+		0xEA, 0xA3, 0x85, 0x2C, 0x69,
+		0xD2, 0xC5, 0xAB, 0xAF, 0xEA,
+	}
+	var a [25]uint8
+	KeccakF200(&a)
+	if a != want {
+		t.Errorf("KeccakF200 = %02X, want %02X", a, want)
+	}
+}
+
+// Sponge outputs generated by _gen/narrow.py, an independent implementation
+// of the reference pseudo-code.
+func TestNarrowSponges(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		h    ShakeHash
+		msg  []byte
+		want string
+	}{
+		{"Keccak-f[800] r=544", NewKeccakF800Sponge(68, 32, 0x01), []byte("abc"), "9d734efa7587904dd24091dddabb5cc4b2f599e8c1bc73143c93be9b37e0c929"},
+		{"Keccak-f[400] r=144", NewKeccakF400Sponge(18, 32, 0x01), []byte("abc"), "414dc05cd4505a4f218461c58c62577099c79be4ef3d5be68e9b420e4f92cf33"},
+		{"Keccak-f[200] r=40", NewKeccakF200Sponge(5, 32, 0x01), []byte("abc"), "37fbb0a32b4a316cd6fe54a181e5fa76a593dfbfac397017e510abb641629996"},
+		{"Keccak-f[800] r=544, 100 bytes", NewKeccakF800Sponge(68, 100, 0x01), ptn(200), "2451a76540e5d12954b51d79d29bedadc95a1449cb37086efdd9fe81eea4710f45d87b81fb0c3c4a1812cabf5a87a86e6a39e08e438a25ff616084d8cdabb82f046c6859c38c06ab4789f491a35e5914b1d53892340acc1a9ada26c20490bd1ce6afe589"},
+	} {
+		tc.h.Write(tc.msg)
+		if got := hex.EncodeToString(tc.h.Sum(nil)); got != tc.want {
+			t.Errorf("%s: Sum = %s, want %s", tc.name, got, tc.want)
+		}
+		out := make([]byte, tc.h.Size())
+		tc.h.Read(out)
+		if got := hex.EncodeToString(out); got != tc.want {
+			t.Errorf("%s: Read = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestNarrowSpongeNotMarshalable(t *testing.T) {
+	h := NewKeccakF800Sponge(68, 32, 0x01).(*state)
+	if _, err := h.MarshalBinary(); err == nil {
+		t.Error("MarshalBinary succeeded on a narrow sponge")
+	}
+	data, _ := NewLegacyKeccak256().(*state).MarshalBinary()
+	if err := h.UnmarshalBinary(data); err == nil {
+		t.Error("UnmarshalBinary accepted a Keccak-256 state into a narrow sponge")
+	}
+}
+
+func TestNarrowSpongeInvalidParameters(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		f    func()
+	}{
+		{"rate too large", func() { NewKeccakF200Sponge(25, 32, 0x01) }},
+		{"zero rate", func() { NewKeccakF400Sponge(0, 32, 0x01) }},
+		{"zero output", func() { NewKeccakF800Sponge(68, 0, 0x01) }},
+		{"zero dsbyte", func() { NewKeccakF800Sponge(68, 32, 0x00) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: did not panic", tc.name)
+				}
+			}()
+			tc.f()
+		}()
+	}
+}
+
+func TestNarrowSpongeSmallWrites(t *testing.T) {
+	msg := ptn(300)
+	h := NewKeccakF200Sponge(5, 32, 0x01)
+	h.Write(msg)
+	want := h.Sum(nil)
+	h.Reset()
+	for i := range msg {
+		h.Write(msg[i : i+1])
+	}
+	if got := h.Sum(nil); !bytes.Equal(got, want) {
+		t.Errorf("byte-at-a-time writes = %x, want %x", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the overwrite mode of the sponge construction, in
+// which each input block replaces the outer part of the state instead of
+// being XORed into it. The input can then be written straight into the
+// state as it arrives, with no separate block buffer and no need to read
+// the previous outer state, which saves memory and work on constrained
+// devices.
+//
+// Overwriting the outer part with a block M is the same as XORing M ⊕ Z
+// into it, where Z is the outer part before absorption. The overwrite mode
+// is thus the XOR mode with inputs that depend on the state, and since the
+// map from M to M ⊕ Z is a bijection for a given state, a collision or a
+// distinguisher in one mode gives one in the other. The generic security
+// strength is the same as that of the XOR mode: half the capacity.
+
+// overwriteSponge is a sponge in overwrite mode. It does not embed the
+// state, so that the methods of the XOR mode, such as MarshalBinary, are
+// not promoted.
+type overwriteSponge struct {
+	s *state
+}
+
+// NewOverwrite returns a sponge over Keccak-f[1600] in overwrite mode. Its
+// parameters are those of New, and a message shorter than the rate has the
+// same digest as with New; longer messages do not.
+//
+// The state of an overwrite-mode sponge cannot be marshaled.
+func NewOverwrite(rate, capacity int, dsbyte byte, outputLen int) (ShakeHash, error) {
+	h, err := New(rate, capacity, dsbyte, outputLen)
+	if err != nil {
+		return nil, err
+	}
+	return &overwriteSponge{s: h.(*state)}, nil
+}
+
+// BlockSize returns the rate of the sponge.
+func (o *overwriteSponge) BlockSize() int { return o.s.rate }
+
+// Size returns the output size of the hash function in bytes.
+func (o *overwriteSponge) Size() int { return o.s.outputLen }
+
+// Reset resets the sponge to its initial state.
+func (o *overwriteSponge) Reset() { o.s.Reset() }
+
+// Write absorbs more data by overwriting the outer part of the state. It
+// panics if any output has already been read.
+func (o *overwriteSponge) Write(p []byte) (n int, err error) {
+	d := o.s
+	if d.state != spongeAbsorbing {
+		panic("keccak: Write after Read")
+	}
+
+	n = len(p)
+	for len(p) > 0 {
+		x := copy(d.a[d.n:d.rate], p)
+		d.n += x
+		p = p[x:]
+		if d.n == d.rate {
+			d.permute()
+		}
+	}
+	return
+}
+
+// Read squeezes an arbitrary number of bytes from the sponge. The first
+// call overwrites the rest of the outer part with the padding.
+func (o *overwriteSponge) Read(out []byte) (n int, err error) {
+	d := o.s
+	if d.state == spongeAbsorbing {
+		// padAndPermute XORs the padding into the state, which is the same
+		// as overwriting once the rest of the block is cleared.
+		clear(d.a[d.n:d.rate])
+		d.padAndPermute()
+	}
+	return d.Read(out)
+}
+
+// Sum appends the digest of the data written so far to in. It does not
+// change the underlying state, and panics if any output has already been
+// read.
+func (o *overwriteSponge) Sum(in []byte) []byte {
+	if o.s.state != spongeAbsorbing {
+		panic("keccak: Sum after Read")
+	}
+
+	dup := o.clone()
+	hash := make([]byte, dup.s.outputLen)
+	_, _ = dup.Read(hash)
+	return append(in, hash...)
+}
+
+func (o *overwriteSponge) clone() *overwriteSponge {
+	return &overwriteSponge{s: o.s.clone()}
+}
+
+// Clone returns a copy of the sponge in its current state.
+func (o *overwriteSponge) Clone() ShakeHash {
+	return o.clone()
+}
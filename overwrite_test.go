@@ -0,0 +1,77 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding"
+	"testing"
+)
+
+// A message shorter than the rate is absorbed into the all-zero state, where
+// overwriting and XORing are the same.
+func TestOverwriteSingleBlock(t *testing.T) {
+	for _, n := range []int{0, 1, 100, rateK256 - 1} {
+		msg := ptn(n)
+		o, err := NewOverwrite(rateK256, 200-rateK256, dsbyteShake, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o.Write(msg)
+		want := make([]byte, 32)
+		ShakeSum128(want, msg)
+		if got := o.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%d bytes: overwrite mode = %x, want %x", n, got, want)
+		}
+	}
+}
+
+// Overwriting a block M is XORing M ⊕ Z, where Z is the outer part of the
+// state before absorption.
+func TestOverwriteEquivalence(t *testing.T) {
+	const rate = rateK512
+	for _, n := range []int{rate, rate + 1, 3*rate - 1, 5 * rate} {
+		msg := ptn(n)
+		o, _ := NewOverwrite(rate, 200-rate, dsbyteKeccak, 32)
+		for i := 0; i < len(msg); i += 50 {
+			o.Write(msg[i:min(i+50, len(msg))])
+		}
+
+		x, _ := New(rate, 200-rate, dsbyteKeccak, 32)
+		s := x.(*state)
+		for p := msg; len(p) > 0; {
+			block := make([]byte, min(len(p), rate))
+			subtle.XORBytes(block, p, s.a[:len(block)])
+			x.Write(block)
+			p = p[len(block):]
+		}
+		// The padding overwrites the rest of the last block too.
+		clear(s.a[s.n:s.rate])
+
+		if got, want := o.Sum(nil), x.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%d bytes: overwrite mode = %x, want %x", n, got, want)
+		}
+	}
+}
+
+func TestOverwriteReadAndClone(t *testing.T) {
+	o, _ := NewOverwrite(rateK256, 200-rateK256, dsbyteShake, 32)
+	o.Write(ptn(1000))
+	c := o.Clone()
+	sum := o.Sum(nil)
+	got, want := make([]byte, 32), make([]byte, 32)
+	o.Read(got)
+	c.Read(want)
+	if !bytes.Equal(got, sum) || !bytes.Equal(want, sum) {
+		t.Errorf("Read = %x, clone Read = %x, Sum = %x", got, want, sum)
+	}
+	if _, ok := o.(encoding.BinaryMarshaler); ok {
+		t.Error("an overwrite-mode sponge implements encoding.BinaryMarshaler")
+	}
+	if _, err := NewOverwrite(100, 101, dsbyteShake, 32); err == nil {
+		t.Error("NewOverwrite accepted an invalid rate and capacity")
+	}
+}
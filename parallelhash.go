@@ -0,0 +1,157 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the ParallelHash128 and ParallelHash256 functions and
+// their ParallelHashXOF variants, as specified in NIST SP 800-185 [1].
+//
+// [1] https://doi.org/10.6028/NIST.SP.800-185
+
+// ParallelHash hashes its input by splitting it into blocks of a fixed
+// size, hashing the blocks independently and then hashing the concatenation
+// of the results. Large writes have their blocks hashed concurrently on up
+// to GOMAXPROCS goroutines.
+//
+// The digest depends on the block size, so all parties must agree on it.
+//
+// ParallelHash implements [hash.XOF].
+type ParallelHash struct {
+	d *state // the outer cSHAKE sponge
+
+	// initBlock is bytepad(encode_string("ParallelHash") || encode_string(S))
+	// followed by left_encode(B), absorbed on construction and on every Reset.
+	initBlock []byte
+
+	// leaves hashes each block with cSHAKE(X_i, 2*cvLen*8, "", ""), which
+	// is SHAKE with the outer sponge's rate.
+	leaves treeLeaves
+
+	// xof is set for the ParallelHashXOF variants, which encode an output
+	// length of zero so that the output can be read to an arbitrary length.
+	xof bool
+}
+
+func newParallelHash(S []byte, blockSize, rate, cvLen, outputLen int, xof bool) *ParallelHash {
+	if blockSize <= 0 {
+		panic("keccak: invalid ParallelHash block size")
+	}
+	var prefix []byte
+	prefix = appendEncodeString(prefix, []byte("ParallelHash"))
+	prefix = appendEncodeString(prefix, S)
+	h := &ParallelHash{
+		d:         newState(state{rate: rate, outputLen: outputLen, dsbyte: dsbyteCShake}),
+		initBlock: append(bytepad(prefix, rate), leftEncode(uint64(blockSize))...),
+		leaves: treeLeaves{
+			leaf:  state{rate: rate, dsbyte: dsbyteShake},
+			size:  blockSize,
+			cvLen: cvLen,
+		},
+		xof: xof,
+	}
+	h.d.Write(h.initBlock)
+	return h
+}
+
+// NewParallelHash128 returns a new ParallelHash128 customized by S, which
+// splits its input into blocks of blockSize bytes and produces digests of
+// outputLen bytes.
+func NewParallelHash128(S []byte, blockSize, outputLen int) *ParallelHash {
+	if outputLen <= 0 {
+		panic("keccak: invalid ParallelHash output length")
+	}
+	return newParallelHash(S, blockSize, rateK256, 32, outputLen, false)
+}
+
+// NewParallelHash256 returns a new ParallelHash256 customized by S, which
+// splits its input into blocks of blockSize bytes and produces digests of
+// outputLen bytes.
+func NewParallelHash256(S []byte, blockSize, outputLen int) *ParallelHash {
+	if outputLen <= 0 {
+		panic("keccak: invalid ParallelHash output length")
+	}
+	return newParallelHash(S, blockSize, rateK512, 64, outputLen, false)
+}
+
+// NewParallelHashXOF128 returns a new ParallelHashXOF128 customized by S,
+// which splits its input into blocks of blockSize bytes. Its output can be
+// read to an arbitrary length; Sum returns the first 32 bytes.
+func NewParallelHashXOF128(S []byte, blockSize int) *ParallelHash {
+	return newParallelHash(S, blockSize, rateK256, 32, 32, true)
+}
+
+// NewParallelHashXOF256 returns a new ParallelHashXOF256 customized by S,
+// which splits its input into blocks of blockSize bytes. Its output can be
+// read to an arbitrary length; Sum returns the first 64 bytes.
+func NewParallelHashXOF256(S []byte, blockSize int) *ParallelHash {
+	return newParallelHash(S, blockSize, rateK512, 64, 64, true)
+}
+
+// BlockSize returns the ParallelHash block size B. Writes that are a
+// multiple of it avoid buffering.
+func (h *ParallelHash) BlockSize() int { return h.leaves.size }
+
+// Size returns the number of bytes Sum will append.
+func (h *ParallelHash) Size() int { return h.d.outputLen }
+
+// Reset discards all data written so far.
+func (h *ParallelHash) Reset() {
+	h.d.Reset()
+	h.d.Write(h.initBlock)
+	h.leaves.reset()
+}
+
+// Write absorbs more data into the hash's state. It panics if any output
+// has already been read.
+func (h *ParallelHash) Write(p []byte) (int, error) {
+	if h.d.state != spongeAbsorbing {
+		panic("keccak: Write after Read")
+	}
+
+	h.leaves.write(h.d, p)
+	return len(p), nil
+}
+
+// finalize hashes any partial block and absorbs the trailing encodings.
+func (h *ParallelHash) finalize() {
+	h.leaves.flush(h.d)
+	h.d.Write(rightEncode(h.leaves.count))
+	if h.xof {
+		h.d.Write(rightEncode(0))
+	} else {
+		h.d.Write(rightEncode(uint64(h.d.outputLen) * 8))
+	}
+}
+
+// Sum appends the digest of the data written so far to in. It does not
+// change the underlying state, and panics if any output has already been
+// read.
+func (h *ParallelHash) Sum(in []byte) []byte {
+	if h.d.state != spongeAbsorbing {
+		panic("keccak: Sum after Read")
+	}
+
+	dup := h.Clone()
+	dup.finalize()
+	hash := make([]byte, dup.d.outputLen)
+	_, _ = dup.d.Read(hash)
+	return append(in, hash...)
+}
+
+// Read squeezes output from the hash. The first call finalizes the input,
+// and subsequent calls to Write or Sum will panic.
+func (h *ParallelHash) Read(out []byte) (n int, err error) {
+	if h.d.state == spongeAbsorbing {
+		h.finalize()
+	}
+	return h.d.Read(out)
+}
+
+// Clone returns a copy of the ParallelHash in its current state.
+func (h *ParallelHash) Clone() *ParallelHash {
+	ret := *h
+	ret.d = h.d.clone()
+	ret.leaves = h.leaves.clone()
+	return &ret
+}
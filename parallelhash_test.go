@@ -0,0 +1,113 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Samples from NIST's ParallelHash_samples.pdf and ParallelHashXOF_samples.pdf.
+func TestParallelHashSamples(t *testing.T) {
+	data := []byte{
+		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+		0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17,
+		0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27,
+	}
+	S := []byte("Parallel Data")
+	for _, tc := range []struct {
+		name string
+		h    *ParallelHash
+		want string
+	}{
+		{"ParallelHash128 #1", NewParallelHash128(nil, 8, 32), "ba8dc1d1d979331d3f813603c67f72609ab5e44b94a0b8f9af46514454a2b4f5"},
+		{"ParallelHash128 #2", NewParallelHash128(S, 8, 32), "fc484dcb3f84dceedc353438151bee58157d6efed0445a81f165e495795b7206"},
+		{"ParallelHash256 #4", NewParallelHash256(nil, 8, 64), "bc1ef124da34495e948ead207dd9842235da432d2bbc54b4c110e64c451105531b7f2a3e0ce055c02805e7c2de1fb746af97a1dd01f43b824e31b87612410429"},
+		{"ParallelHash256 #5", NewParallelHash256(S, 8, 64), "cdf15289b54f6212b4bc270528b49526006dd9b54e2b6add1ef6900dda3963bb33a72491f236969ca8afaea29c682d47a393c065b38e29fae651a2091c833110"},
+		{"ParallelHashXOF128 #1", NewParallelHashXOF128(nil, 8), "fe47d661e49ffe5b7d999922c062356750caf552985b8e8ce6667f2727c3c8d3"},
+		{"ParallelHashXOF128 #2", NewParallelHashXOF128(S, 8), "ea2a793140820f7a128b8eb70a9439f93257c6e6e79b4a540d291d6dae7098d7"},
+		{"ParallelHashXOF256 #4", NewParallelHashXOF256(nil, 8), "c10a052722614684144d28474850b410757e3cba87651ba167a5cbddff7f466675fbf84bcae7378ac444be681d729499afca667fb879348bfdda427863c82f1c"},
+		{"ParallelHashXOF256 #5", NewParallelHashXOF256(S, 8), "538e105f1a22f44ed2f5cc1674fbd40be803d9c99bf5f8d90a2c8193f3fe6ea768e5c1a20987e2c9c65febed03887a51d35624ed12377594b5585541dc377efc"},
+	} {
+		tc.h.Write(data)
+		if got := hex.EncodeToString(tc.h.Sum(nil)); got != tc.want {
+			t.Errorf("%s: Sum = %s, want %s", tc.name, got, tc.want)
+		}
+		out := make([]byte, tc.h.Size())
+		tc.h.Read(out)
+		if got := hex.EncodeToString(out); got != tc.want {
+			t.Errorf("%s: Read = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestParallelHashLarge(t *testing.T) {
+	// Large enough to be hashed concurrently, with a trailing partial block.
+	data := make([]byte, 100000)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	for _, tc := range []struct {
+		name string
+		h    *ParallelHash
+		msg  []byte
+		want string
+	}{
+		{"ParallelHash128", NewParallelHash128(nil, 1000, 32), data, "c6385496081253bd6f30a5abb57815e298ab6e33ff287c4f6d60498d327fd047"},
+		{"ParallelHash256", NewParallelHash256([]byte("S"), 8192, 64), data[:99999], "56b82e130c9483b8957dca3ce3e95d98f2743be6b4f0dd379c878a119fb99ac69bf8a2e0412bcc10440042a3dbc727da30d707e7021e569612cfe8db6e7397da"},
+	} {
+		tc.h.Write(tc.msg)
+		if got := hex.EncodeToString(tc.h.Sum(nil)); got != tc.want {
+			t.Errorf("%s: one write = %s, want %s", tc.name, got, tc.want)
+		}
+
+		// Uneven writes must buffer partial blocks correctly.
+		tc.h.Reset()
+		msg := tc.msg
+		for i := 1; len(msg) > 0; i = i*3 + 1 {
+			x := min(i, len(msg))
+			tc.h.Write(msg[:x])
+			msg = msg[x:]
+		}
+		if got := hex.EncodeToString(tc.h.Sum(nil)); got != tc.want {
+			t.Errorf("%s: uneven writes = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestParallelHashEmpty(t *testing.T) {
+	want := "96427c30224408859f95e89e4fa84e1c7a1478dbf2008ac982ce61a77f37a272"
+	if got := hex.EncodeToString(NewParallelHash128(nil, 8, 32).Sum(nil)); got != want {
+		t.Errorf("ParallelHash128('') = %s, want %s", got, want)
+	}
+}
+
+func TestParallelHashClone(t *testing.T) {
+	h := NewParallelHashXOF256(nil, 64)
+	h.Write(bytes.Repeat([]byte{1}, 100))
+	c := h.Clone()
+	h.Write([]byte("diverge"))
+	c.Write([]byte("diverge"))
+
+	a := make([]byte, 300)
+	b := make([]byte, 300)
+	h.Read(a)
+	c.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("clone produced different output")
+	}
+}
+
+func BenchmarkParallelHash128_1M(b *testing.B) {
+	data := make([]byte, 1<<20)
+	b.SetBytes(int64(len(data)))
+	h := NewParallelHash128(nil, 8192, 32)
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
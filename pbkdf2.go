@@ -0,0 +1,67 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides PBKDF2, the password-based key derivation function of
+// RFC 8018 [1], for use with the legacy Keccak hashes of this package. It
+// is adapted from golang.org/x/crypto/pbkdf2.
+//
+// [1] https://www.rfc-editor.org/rfc/rfc8018#section-5.2
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+)
+
+// PBKDF2 derives a key of keyLen bytes from password and salt with iter
+// iterations of HMAC over the hash function h, such as NewLegacyKeccak256.
+//
+// New applications should prefer a memory-hard function such as Argon2;
+// PBKDF2 is provided for compatibility with existing keys, such as those
+// of wallets that derive keys with PBKDF2 over Keccak-256.
+func PBKDF2(password, salt []byte, iter, keyLen int, h func() hash.Hash) ([]byte, error) {
+	if iter < 1 {
+		return nil, errors.New("keccak: PBKDF2 iteration count must be positive")
+	}
+	if keyLen <= 0 {
+		return nil, errors.New("keccak: invalid PBKDF2 key length")
+	}
+
+	prf := hmac.New(h, password)
+	hashLen := prf.Size()
+	numBlocks := (keyLen + hashLen - 1) / hashLen
+
+	var buf [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	U := make([]byte, hashLen)
+	for block := 1; block <= numBlocks; block++ {
+		// N.B.: || means concatenation, ^ means XOR
+		// for each block T_i = U_1 ^ U_2 ^ ... ^ U_iter
+		// U_1 = PRF(password, salt || uint(i))
+		prf.Reset()
+		prf.Write(salt)
+		buf[0] = byte(block >> 24)
+		buf[1] = byte(block >> 16)
+		buf[2] = byte(block >> 8)
+		buf[3] = byte(block)
+		prf.Write(buf[:4])
+		dk = prf.Sum(dk)
+		T := dk[len(dk)-hashLen:]
+		copy(U, T)
+
+		// U_n = PRF(password, U_(n-1))
+		for n := 2; n <= iter; n++ {
+			prf.Reset()
+			prf.Write(U)
+			U = U[:0]
+			U = prf.Sum(U)
+			for x := range U {
+				T[x] ^= U[x]
+			}
+		}
+	}
+	return dk[:keyLen], nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	stdpbkdf2 "crypto/pbkdf2"
+	"crypto/sha1"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func TestPBKDF2RFC6070(t *testing.T) {
+	// RFC 6070, with HMAC-SHA1.
+	for _, tc := range []struct {
+		password, salt string
+		iter, keyLen   int
+		want           string
+	}{
+		{"password", "salt", 1, 20, "0c60c80f961f0e71f3a9b524af6012062fe037a6"},
+		{"password", "salt", 4096, 20, "4b007901b765489abead49d926f721d065a429c1"},
+		{"passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096, 25, "3d2eec4fe41c849b80c8d83662c0e44a8b291a964cf2f07038"},
+	} {
+		got, err := PBKDF2([]byte(tc.password), []byte(tc.salt), tc.iter, tc.keyLen, sha1.New)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(got) != tc.want {
+			t.Errorf("PBKDF2(%q, %q, %d) = %x, want %s", tc.password, tc.salt, tc.iter, got, tc.want)
+		}
+	}
+}
+
+func TestPBKDF2Keccak(t *testing.T) {
+	// HMAC must use the rate of the legacy Keccak hashes as block size;
+	// the standard library PBKDF2 does the same through hash.Hash.
+	for _, h := range []func() hash.Hash{NewLegacyKeccak256, NewLegacyKeccak512} {
+		for _, keyLen := range []int{16, 32, 100} {
+			want, err := stdpbkdf2.Key(h, "password", []byte("salt"), 100, keyLen)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := PBKDF2([]byte("password"), []byte("salt"), 100, keyLen, h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("size %d, length %d: output differs from crypto/pbkdf2", h().Size(), keyLen)
+			}
+		}
+	}
+}
+
+func TestPBKDF2Parameters(t *testing.T) {
+	if _, err := PBKDF2(nil, nil, 0, 32, NewLegacyKeccak256); err == nil {
+		t.Error("accepted zero iterations")
+	}
+	if _, err := PBKDF2(nil, nil, 1, 0, NewLegacyKeccak256); err == nil {
+		t.Error("accepted a zero key length")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// KeccakF1600 applies the Keccak-f[1600] permutation to a, using the same
+// optimized implementation as the hash functions in this package. It is
+// intended for building sponge and duplex constructions not provided here.
+//
+// Lane (x, y) of the state is a[x+5*y]. The sponge functions in this
+// package map the byte string of the state to lanes in little-endian order,
+// so that byte i of the state is byte i%8 of lane i/8.
+func KeccakF1600(a *[25]uint64) {
+	keccakF1600(a)
+}
+
+// KeccakP1600 applies the round-reduced Keccak-p[1600, rounds] permutation
+// to a, which consists of the last rounds rounds of Keccak-f[1600]. Common
+// choices are 12 rounds, as used by TurboSHAKE and KangarooTwelve, and 14
+// rounds, as used by MarsupilamiFourteen. With rounds set to 24 it is
+// equivalent to KeccakF1600 and uses the same optimized implementation.
+//
+// The state layout is the same as for KeccakF1600. KeccakP1600 panics if
+// rounds is not between 1 and 24.
+func KeccakP1600(a *[25]uint64, rounds int) {
+	if rounds < 1 || rounds > 24 {
+		panic("keccak: Keccak-p[1600] round count must be between 1 and 24")
+	}
+	permute1600(a, rounds)
+}
+
+// permute1600 applies the last rounds rounds of Keccak-f[1600], dispatching
+// the full permutation to the fastest available implementation.
+func permute1600(a *[25]uint64, rounds int) {
+	if rounds == 24 {
+		keccakF1600(a)
+	} else {
+		keccakP1600Complemented(a, rounds)
+	}
+}
+
+// KeccakF800 applies the 22-round Keccak-f[800] permutation to a. Lane
+// (x, y) of the state is a[x+5*y], and the sponges returned by
+// NewKeccakF800Sponge map bytes to lanes in little-endian order.
+func KeccakF800(a *[25]uint32) {
+	keccakFNarrow(a, roundsF800, roundsF800)
+}
+
+// KeccakF400 applies the 20-round Keccak-f[400] permutation to a. Lane
+// (x, y) of the state is a[x+5*y], and the sponges returned by
+// NewKeccakF400Sponge map bytes to lanes in little-endian order.
+func KeccakF400(a *[25]uint16) {
+	keccakFNarrow(a, roundsF400, roundsF400)
+}
+
+// KeccakF200 applies the 18-round Keccak-f[200] permutation to a. Lane
+// (x, y) of the state is a[x+5*y].
+func KeccakF200(a *[25]uint8) {
+	keccakFNarrow(a, roundsF200, roundsF200)
+}
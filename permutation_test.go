@@ -0,0 +1,172 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "testing"
+
+// Test vectors from KeccakF-1600-IntermediateValues.txt in the Keccak
+// reference package: the permutation applied once and twice to the zero
+// state.
+var keccakF1600Vectors = [2][25]uint64{
+	{
+		0xF1258F7940E1DDE7, 0x84D5CCF933C0478A, 0xD598261EA65AA9EE, 0xBD1547306F80494D, 0x8B284E056253D057,
+		0xFF97A42D7F8E6FD4, 0x90FEE5A0A44647C4, 0x8C5BDA0CD6192E76, 0xAD30A6F71B19059C, 0x30935AB7D08FFC64,
+		0xEB5AA93F2317D635, 0xA9A6E6260D712103, 0x81A57C16DBCF555F, 0x43B831CD0347C826, 0x01F22F1A11A5569F,
+		0x05E5635A21D9AE61, 0x64BEFEF28CC970F2, 0x613670957BC46611, 0xB87C5A554FD00ECB, 0x8C3EE88A1CCF32C8,
+		0x940C7922AE3A2614, 0x1841F924A2C509E4, 0x16F53526E70465C2, 0x75F644E97F30A13B, 0xEAF1FF7B5CECA249,
+	},
+	{
+		0x2D5C954DF96ECB3C, 0x6A332CD07057B56D, 0x093D8D1270D76B6C, 0x8A20D9B25569D094, 0x4F9C4F99E5E7F156,
+		0xF957B9A2DA65FB38, 0x85773DAE1275AF0D, 0xFAF4F247C3D810F7, 0x1F1B9EE6F79A8759, 0xE4FECC0FEE98B425,
+		0x68CE61B6B9CE68A1, 0xDEEA66C4BA8F974F, 0x33C43D836EAFB1F5, 0xE00654042719DBD9, 0x7CF8A9F009831265,
+		0xFD5449A6BF174743, 0x97DDAD33D8994B40, 0x48EAD5FC5D0BE774, 0xE3B8C8EE55B7B03C, 0x91A0226E649E42E9,
+		0x900E3129E7BADD7B, 0x202A9EC5FAA3CCE8, 0x5B3402464E1C3DB6, 0x609F4E62A44C1059, 0x20D06CD26A8FBF5C,
+	},
+}
+
+func TestKeccakF1600(t *testing.T) {
+	var a [25]uint64
+	for i, want := range keccakF1600Vectors {
+		KeccakF1600(&a)
+		if a != want {
+			t.Errorf("KeccakF1600 applied %d times = %016X, want %016X", i+1, a, want)
+		}
+	}
+}
+
+func TestKeccakP1600(t *testing.T) {
+	// Keccak-p[1600, 12] and Keccak-p[1600, 14] applied to the zero state,
+	// generated by _gen/permutation.py, an independent implementation of
+	// the reference pseudo-code.
+	for _, tc := range []struct {
+		rounds int
+		want   [25]uint64
+	}{
+		{12, [25]uint64{
+			0x8E5E5438B9A78617, 0xD9CD6A50F259D01E, 0x87B8E7C652A91F35, 0x1093E067CDE4E0C5, 0xB033AB90F2D95A45,
+			0xE0A72F72A8DD1A45, 0xC53780AA14672F9C, 0x3EDD47F50051071D, 0xB3A31D310C178ACC, 0x79B586A59257AAA0,
+			0xBC4A7C3DB3B1F99B, 0x68874063E68A6793, 0x5C6C03332E0E2566, 0x9CAA1202B9F030DA, 0x5F3B9A782BCF7A9F,
+			0xE536C1E061AE7923, 0x6DE9B618B73C87EC, 0x2ABED1F170918AC2, 0x6AABBD53DAED24B7, 0xBFC1416A2C2EE15A,
+			0xC6CFE036B90952AF, 0x45503617DC7060D7, 0x625611B2C29F7AE4, 0xD43671DB2C30647A, 0xCFFD0D76222CA01C,
+		}},
+		{14, [25]uint64{
+			0x93055C6025AE39F4, 0xFFBA77C16772F3A5, 0x51ED61D5555A51EA, 0x5358D0835CE5F0CC, 0xAE01AC77DF72FB3E,
+			0xE0767F85AC129A50, 0xCE0B02509CD0F064, 0xF7CFCE4BF6F57FCA, 0xAC81B1F1908316E1, 0x4DEBF0A389590553,
+			0x86092CE8FA183B03, 0x4E591644A4D9C2AD, 0x2AB0A369261CA0DD, 0xD3198C101AA84596, 0x6101474A582C10CE,
+			0x861648623AE90D39, 0xE465E43209057AD6, 0x3BB6AA81191AE532, 0xE9545E5542A6B7E2, 0x4231AE72A53C78BC,
+			0xA78626648D818094, 0x415594F60CFCCD6E, 0xE0B098A311C22888, 0x6F17D285E131AEE8, 0x43F8D0E199901150,
+		}},
+		{24, keccakF1600Vectors[0]},
+	} {
+		var a [25]uint64
+		KeccakP1600(&a, tc.rounds)
+		if a != tc.want {
+			t.Errorf("KeccakP1600(%d rounds) = %016X, want %016X", tc.rounds, a, tc.want)
+		}
+	}
+}
+
+func TestKeccakP1600InvalidRounds(t *testing.T) {
+	for _, rounds := range []int{-1, 0, 25} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("KeccakP1600(%d rounds) did not panic", rounds)
+				}
+			}()
+			var a [25]uint64
+			KeccakP1600(&a, rounds)
+		}()
+	}
+}
+
+func TestKeccakF1600MatchesGeneric(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	KeccakF1600(&a)
+	keccakP1600(&b, 24)
+	if a != b {
+		t.Error("KeccakF1600 disagrees with the generic permutation")
+	}
+}
+
+func BenchmarkKeccakF1600(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		KeccakF1600(&a)
+	}
+}
+
+func TestKeccakF1600Interleaved(t *testing.T) {
+	for rounds := 0; rounds <= 24; rounds++ {
+		var a, b [25]uint64
+		for i := range a {
+			a[i] = uint64(i+rounds) * 0x9e3779b97f4a7c15
+		}
+		b = a
+		keccakF1600Interleaved(&a, rounds)
+		keccakP1600(&b, rounds)
+		if a != b {
+			t.Errorf("interleaved permutation with %d rounds disagrees with the generic one", rounds)
+		}
+	}
+}
+
+func TestKeccakF1600Complemented(t *testing.T) {
+	for rounds := 0; rounds <= 24; rounds++ {
+		var a, b [25]uint64
+		for i := range a {
+			a[i] = uint64(i+rounds) * 0x9e3779b97f4a7c15
+		}
+		b = a
+		keccakP1600Complemented(&a, rounds)
+		keccakP1600(&b, rounds)
+		if a != b {
+			t.Errorf("complemented permutation with %d rounds disagrees with the generic one", rounds)
+		}
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	for _, lane := range []uint64{0, 1, 2, 0x8000000000000000, 0xAAAAAAAAAAAAAAAA, 0x0123456789ABCDEF} {
+		e, o := interleave(lane)
+		for i := 0; i < 32; i++ {
+			if uint32(lane>>(2*i))&1 != (e>>i)&1 || uint32(lane>>(2*i+1))&1 != (o>>i)&1 {
+				t.Fatalf("interleave(%#x) = %#x, %#x", lane, e, o)
+			}
+		}
+		if got := deinterleave(e, o); got != lane {
+			t.Errorf("deinterleave(interleave(%#x)) = %#x", lane, got)
+		}
+	}
+}
+
+func BenchmarkKeccakF1600Generic(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakP1600(&a, 24)
+	}
+}
+
+func BenchmarkKeccakF1600Interleaved(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600Interleaved(&a, 24)
+	}
+}
+
+func BenchmarkKeccakF1600Complemented(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakP1600Complemented(&a, 24)
+	}
+}
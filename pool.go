@@ -0,0 +1,34 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"hash"
+	"sync"
+)
+
+var keccak256Pool = sync.Pool{
+	New: func() any { return NewLegacyKeccak256() },
+}
+
+// GetKeccak256 returns a Keccak-256 hash in its initial state, reusing one
+// returned by PutKeccak256 if there is any. It saves the allocation of
+// NewLegacyKeccak256 in services that hash streams on many goroutines; for
+// data that is already in memory, Sum256 does not allocate at all.
+func GetKeccak256() hash.Hash {
+	return keccak256Pool.Get().(hash.Hash)
+}
+
+// PutKeccak256 resets h and makes it available to later calls to
+// GetKeccak256. The caller must not use h afterwards. PutKeccak256 panics if
+// h was not returned by GetKeccak256 or NewLegacyKeccak256.
+func PutKeccak256(h hash.Hash) {
+	d, ok := h.(*state)
+	if !ok || d.rate != rateK512 || d.outputLen != 32 || d.dsbyte != dsbyteKeccak || d.rounds != 0 || d.width != 0 {
+		panic("keccak: PutKeccak256 of a hash that is not Keccak-256")
+	}
+	d.Reset()
+	keccak256Pool.Put(d)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestKeccak256Pool(t *testing.T) {
+	// Hashes put back half-written come out of the pool in their initial
+	// state, whichever goroutine gets them.
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := range 100 {
+				msg := ptn(g*100 + i)
+				h := GetKeccak256()
+				h.Write(msg)
+				want := Sum256(msg)
+				if got := h.Sum(nil); !bytes.Equal(got, want[:]) {
+					t.Errorf("pooled hash of %d bytes = %x, want %x", len(msg), got, want)
+				}
+				h.Write(msg)
+				PutKeccak256(h)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestPutKeccak256Mismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("PutKeccak256 of a SHA3-256 hash did not panic")
+		}
+	}()
+	PutKeccak256(New256())
+}
+
+func BenchmarkKeccak256Pool(b *testing.B) {
+	data := make([]byte, 32)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		var digest [32]byte
+		for pb.Next() {
+			h := GetKeccak256()
+			h.Write(data)
+			h.Sum(digest[:0])
+			PutKeccak256(h)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file builds the init code of proxy contracts deployed by clone
+// factories, and predicts the addresses of the clones. A clone deployed
+// with CREATE has the address CreateAddress(factory, nonce), whatever its
+// code, so only CREATE2 needs the init code.
+
+import "encoding/binary"
+
+// The ERC-1167 minimal proxy is minimalProxyPrefix, the implementation
+// address and minimalProxySuffix. Its first 10 bytes copy the 45 bytes of
+// runtime code that follow them to memory and return them; the runtime code
+// delegates every call to the implementation.
+const (
+	minimalProxyPrefix = "\x3d\x60\x2d\x80\x60\x0a\x3d\x39\x81\xf3" +
+		"\x36\x3d\x3d\x37\x3d\x3d\x3d\x36\x3d\x73"
+	minimalProxySuffix = "\x5a\xf4\x3d\x82\x80\x3e\x90\x3d\x91\x60\x2b\x57\xfd\x5b\xf3"
+)
+
+// MinimalProxyInitCode returns the init code of an ERC-1167 minimal proxy
+// delegating to implementation, as deployed by the Clones library of
+// OpenZeppelin and most clone factories.
+func MinimalProxyInitCode(implementation [AddressLength]byte) []byte {
+	code := make([]byte, 0, len(minimalProxyPrefix)+AddressLength+len(minimalProxySuffix))
+	code = append(code, minimalProxyPrefix...)
+	code = append(code, implementation[:]...)
+	return append(code, minimalProxySuffix...)
+}
+
+// MinimalProxyAddress returns the address of the ERC-1167 minimal proxy
+// delegating to implementation that deployer creates with CREATE2 and salt,
+// as predictDeterministicAddress of the OpenZeppelin Clones library.
+func MinimalProxyAddress(deployer, implementation [AddressLength]byte, salt [32]byte) [AddressLength]byte {
+	var code [len(minimalProxyPrefix) + AddressLength + len(minimalProxySuffix)]byte
+	copy(code[:], minimalProxyPrefix)
+	copy(code[len(minimalProxyPrefix):], implementation[:])
+	copy(code[len(minimalProxyPrefix)+AddressLength:], minimalProxySuffix)
+	return Create2AddressFromCode(deployer, salt, code[:])
+}
+
+// The clones with immutable arguments of the ClonesWithImmutableArgs
+// library are immutableArgsCreate, the size of the runtime code,
+// immutableArgsCopy, the size of the arguments, immutableArgsAppend, the
+// size of the arguments again, immutableArgsPush, the implementation
+// address, immutableArgsCall, the arguments and their size. All sizes are
+// 16-bit big-endian, and the size of the arguments counts the 2 bytes of
+// the size appended to them.
+//
+// The first 10 bytes copy the runtime code that follows them to memory and
+// return it. The runtime code copies the call data to memory, appends the
+// arguments and their size, which follow its 55 bytes, and delegates the
+// call to the implementation, which reads them from the end of its call
+// data. It then returns or reverts with the data returned.
+const (
+	immutableArgsCreate = "\x61"
+	immutableArgsCopy   = "\x3d\x81\x60\x0a\x3d\x39\xf3\x3d\x3d\x3d\x3d\x36\x3d\x3d\x37\x61"
+	immutableArgsAppend = "\x60\x37\x36\x39\x36\x61"
+	immutableArgsPush   = "\x01\x3d\x73"
+	immutableArgsCall   = "\x5a\xf4\x3d\x3d\x93\x80\x3e\x60\x35\x57\xfd\x5b\xf3"
+)
+
+// ImmutableArgsCloneInitCode returns the init code of a clone delegating
+// to implementation with the immutable arguments args appended to every
+// call, as built by the ClonesWithImmutableArgs library. The arguments are
+// the values packed by abi.encodePacked. ImmutableArgsCloneInitCode panics
+// if args are too long for the 16-bit sizes of the code.
+func ImmutableArgsCloneInitCode(implementation [AddressLength]byte, args []byte) []byte {
+	const codeSize = len(immutableArgsCreate) + 2 + len(immutableArgsCopy) + 2 +
+		len(immutableArgsAppend) + 2 + len(immutableArgsPush) + AddressLength + len(immutableArgsCall)
+	extra := len(args) + 2
+	runSize := codeSize - 10 + extra
+	if runSize > 0xffff {
+		panic("keccak: immutable arguments of a clone too long")
+	}
+
+	code := make([]byte, 0, codeSize+extra)
+	code = append(code, immutableArgsCreate...)
+	code = binary.BigEndian.AppendUint16(code, uint16(runSize))
+	code = append(code, immutableArgsCopy...)
+	code = binary.BigEndian.AppendUint16(code, uint16(extra))
+	code = append(code, immutableArgsAppend...)
+	code = binary.BigEndian.AppendUint16(code, uint16(extra))
+	code = append(code, immutableArgsPush...)
+	code = append(code, implementation[:]...)
+	code = append(code, immutableArgsCall...)
+	code = append(code, args...)
+	return binary.BigEndian.AppendUint16(code, uint16(extra))
+}
+
+// ImmutableArgsCloneAddress returns the address of the clone delegating to
+// implementation with the immutable arguments args that deployer creates
+// with CREATE2 and salt.
+func ImmutableArgsCloneAddress(deployer, implementation [AddressLength]byte, args []byte, salt [32]byte) [AddressLength]byte {
+	return Create2AddressFromCode(deployer, salt, ImmutableArgsCloneInitCode(implementation, args))
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestMinimalProxy(t *testing.T) {
+	var impl, deployer [AddressLength]byte
+	hex.Decode(impl[:], []byte("bebebebebebebebebebebebebebebebebebebebe"))
+	hex.Decode(deployer[:], []byte("5fbdb2315678afecb367f032d93f642f64180aa3"))
+
+	// The constructor of the Clones library, then the runtime code given
+	// by ERC-1167.
+	const want = "3d602d80600a3d3981f3" +
+		"363d3d373d3d3d363d73bebebebebebebebebebebebebebebebebebebebe5af43d82803e903d91602b57fd5bf3"
+	code := MinimalProxyInitCode(impl)
+	if got := hex.EncodeToString(code); got != want {
+		t.Errorf("MinimalProxyInitCode = %s, want %s", got, want)
+	}
+
+	// The addresses at which go-ethereum's EVM (core/vm/runtime, v1.16.5)
+	// deployed the init code with CREATE2, run by a factory contract at
+	// the deployer address. Calling each clone returned the calldata echoed
+	// by an implementation at impl.
+	for _, tc := range []struct {
+		salt [32]byte
+		want string
+	}{
+		{[32]byte{}, "d10a6e10f341f8e6812485a06748ffeae6d60cd4"},
+		{[32]byte{31: 1}, "e7f08455c6e0f72819a122837fbe5962abac51a0"},
+		{Sum256([]byte("salt")), "708d9ee137d5c9e87a74002e2368384fc729a74a"},
+	} {
+		got := MinimalProxyAddress(deployer, impl, tc.salt)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("MinimalProxyAddress(salt %x) = %x, want %s", tc.salt, got, tc.want)
+		}
+		if got != Create2AddressFromCode(deployer, tc.salt, code) {
+			t.Errorf("MinimalProxyAddress(salt %x) differs from the CREATE2 address of its init code", tc.salt)
+		}
+	}
+}
+
+func TestImmutableArgsClone(t *testing.T) {
+	var impl, deployer [AddressLength]byte
+	hex.Decode(impl[:], []byte("bebebebebebebebebebebebebebebebebebebebe"))
+	hex.Decode(deployer[:], []byte("5fbdb2315678afecb367f032d93f642f64180aa3"))
+	args := []byte{1, 2, 3}
+
+	// 60 bytes of runtime code, of which 5 are the arguments and their size.
+	const want = "61003c3d81600a3d39f3" +
+		"3d3d3d3d363d3d37610005603736393661000501" +
+		"3d73bebebebebebebebebebebebebebebebebebebebe5af43d3d93803e603557fd5bf3" +
+		"0102030005"
+	code := ImmutableArgsCloneInitCode(impl, args)
+	if got := hex.EncodeToString(code); got != want {
+		t.Errorf("ImmutableArgsCloneInitCode = %s, want %s", got, want)
+	}
+	// The runtime code jumps to its JUMPDEST at 0x35, and copies the
+	// arguments from 0x37.
+	if runtime := code[10:]; runtime[0x35] != 0x5b || hex.EncodeToString(runtime[0x37:]) != "0102030005" {
+		t.Errorf("runtime code %x does not match its offsets", runtime)
+	}
+
+	// The address at which go-ethereum's EVM deployed the init code, as in
+	// TestMinimalProxy. Calling the clone with "hi" returned 68 69 01 02 03
+	// 00 05: the calldata followed by the arguments and their size.
+	salt := Sum256([]byte("salt"))
+	const wantAddr = "470af2de27f6155f04114c5c9500d32198c3d0e7"
+	if got := ImmutableArgsCloneAddress(deployer, impl, args, salt); hex.EncodeToString(got[:]) != wantAddr {
+		t.Errorf("ImmutableArgsCloneAddress = %x, want %s", got, wantAddr)
+	}
+
+	ImmutableArgsCloneInitCode(impl, make([]byte, 0xffff-57))
+	defer func() {
+		if recover() == nil {
+			t.Error("ImmutableArgsCloneInitCode with too long arguments did not panic")
+		}
+	}()
+	ImmutableArgsCloneInitCode(impl, make([]byte, 0xffff-56))
+}
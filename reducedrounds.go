@@ -0,0 +1,55 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_reducedrounds
+
+package keccak
+
+// This file provides round-reduced variants of the Keccak hashes, for
+// cryptanalysis and for differential testing against other implementations.
+// They offer no security whatsoever, so they are only compiled in with the
+// keccak_reducedrounds build tag.
+
+import "hash"
+
+// newReduced returns a sponge over the last rounds rounds of Keccak-f[1600].
+// It panics if rounds is not between 1 and 24.
+func newReduced(rate, outputLen int, dsbyte byte, rounds int) *state {
+	if rounds < 1 || rounds > 24 {
+		panic("keccak: invalid number of rounds")
+	}
+	return newState(state{rate: rate, outputLen: outputLen, dsbyte: dsbyte, rounds: rounds})
+}
+
+// NewReducedLegacyKeccak256 creates a new Keccak-256 hash over the last
+// rounds rounds of Keccak-f[1600]. It panics if rounds is not between 1
+// and 24.
+func NewReducedLegacyKeccak256(rounds int) hash.Hash {
+	return newReduced(rateK512, 32, dsbyteKeccak, rounds)
+}
+
+// NewReducedLegacyKeccak512 creates a new Keccak-512 hash over the last
+// rounds rounds of Keccak-f[1600]. It panics if rounds is not between 1
+// and 24.
+func NewReducedLegacyKeccak512(rounds int) hash.Hash {
+	return newReduced(rateK1024, 64, dsbyteKeccak, rounds)
+}
+
+// NewReducedSHA3256 creates a new SHA3-256 hash over the last rounds rounds
+// of Keccak-f[1600]. It panics if rounds is not between 1 and 24.
+func NewReducedSHA3256(rounds int) hash.Hash {
+	return newReduced(rateK512, 32, dsbyteSHA3, rounds)
+}
+
+// NewReducedShake128 creates a new SHAKE128 XOF over the last rounds rounds
+// of Keccak-f[1600]. It panics if rounds is not between 1 and 24.
+func NewReducedShake128(rounds int) ShakeHash {
+	return newReduced(rateK256, 32, dsbyteShake, rounds)
+}
+
+// NewReducedShake256 creates a new SHAKE256 XOF over the last rounds rounds
+// of Keccak-f[1600]. It panics if rounds is not between 1 and 24.
+func NewReducedShake256(rounds int) ShakeHash {
+	return newReduced(rateK512, 64, dsbyteShake, rounds)
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_reducedrounds
+
+package keccak
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// Test vectors generated by _gen/reducedrounds.py.
+func TestReducedRoundsVectors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		h    func(int) []byte
+		r    int
+		want string
+	}{
+		{"Keccak-256", func(r int) []byte { return sumReduced(NewReducedLegacyKeccak256(r), "abc") }, 4, "6ddb80c09d58ad50dcd774505be1e7587d180bde1618b1cfd2e8a605be8cf3fe"},
+		{"Keccak-256", func(r int) []byte { return sumReduced(NewReducedLegacyKeccak256(r), "abc") }, 8, "f362ae34151bdcdb36260205797116cfc60deaee4e73431071e50474756a2bc5"},
+		{"SHAKE128", func(r int) []byte { return sumReduced(NewReducedShake128(r), "abc") }, 4, "36c6c15337813ac8b5af5c600af655d34f5639b85f61e5419e238dacf873b7e4"},
+	} {
+		if got := hex.EncodeToString(tc.h(tc.r)); got != tc.want {
+			t.Errorf("%s with %d rounds = %s, want %s", tc.name, tc.r, got, tc.want)
+		}
+	}
+}
+
+func sumReduced(h hash.Hash, msg string) []byte {
+	h.Write([]byte(msg))
+	return h.Sum(nil)
+}
+
+// With all 24 rounds the reduced hashes are the standard ones, and with 12
+// rounds SHAKE is TurboSHAKE with the SHAKE domain separation byte.
+func TestReducedRoundsConsistency(t *testing.T) {
+	msg := ptn(1000)
+	for _, tc := range []struct {
+		name      string
+		got, want []byte
+	}{
+		{"Keccak-256", sumReduced(NewReducedLegacyKeccak256(24), string(msg)), sumReduced(NewLegacyKeccak256(), string(msg))},
+		{"Keccak-512", sumReduced(NewReducedLegacyKeccak512(24), string(msg)), sumReduced(NewLegacyKeccak512(), string(msg))},
+		{"SHA3-256", sumReduced(NewReducedSHA3256(24), string(msg)), sumReduced(New256(), string(msg))},
+		{"SHAKE256", sumReduced(NewReducedShake256(24), string(msg)), sumReduced(NewShake256(), string(msg))},
+		{"SHAKE128/12", sumReduced(NewReducedShake128(12), string(msg)), sumReduced(NewTurboShake128(dsbyteShake), string(msg))},
+	} {
+		if !bytes.Equal(tc.got, tc.want) {
+			t.Errorf("%s = %x, want %x", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func TestReducedRoundsInvalid(t *testing.T) {
+	for _, r := range []int{-1, 0, 25} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewReducedShake128(%d) did not panic", r)
+				}
+			}()
+			NewReducedShake128(r)
+		}()
+	}
+}
+
+// A round-reduced state must never be mistaken for a full-round one.
+func TestReducedRoundsMarshal(t *testing.T) {
+	// With 12 rounds, as TurboSHAKE, they are not TurboSHAKE states either.
+	for _, rounds := range []int{4, 12} {
+		h := NewReducedLegacyKeccak256(rounds)
+		if _, err := h.(encoding.BinaryMarshaler).MarshalBinary(); err == nil {
+			t.Errorf("MarshalBinary of a %d-round state succeeded", rounds)
+		}
+	}
+	data, err := NewTurboShake128(0x1f).(*state).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewReducedShake128(12).(*state).UnmarshalBinary(data); err == nil {
+		t.Error("a 12-round SHAKE128 accepted a TurboSHAKE state")
+	}
+}
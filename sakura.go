@@ -0,0 +1,86 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the Sakura coding of tree hash modes [1]. A Sakura tree
+// is made of nodes whose last bits say whether the node is the final node,
+// and whether it holds message bits or chaining values of other nodes.
+// Trees coded this way are sound whatever their shape, and any two
+// implementations agreeing on the coding and the shape of the tree compute
+// the same digests.
+//
+// The only shape implemented here is kangaroo hopping, where the final node
+// absorbs the first chunk of the message itself, followed by the chaining
+// values of the leaves holding the rest. KangarooTwelve uses it on top of
+// TurboSHAKE, and NewSakuraShake128 and NewSakuraShake256 on top of
+// RawSHAKE, the SHAKE functions without their Sakura suffix.
+//
+// [1] https://keccak.team/files/Sakura.pdf
+
+// sakuraCoding holds the domain separation bytes of the nodes of a tree.
+// Each merges the Sakura frame bits of the node with the suffix of the
+// inner function, if any, and the first bit of the padding.
+type sakuraCoding struct {
+	single byte // a final node made of the whole message: '11'
+	final  byte // a final node ending with chaining values: '01'
+	leaf   byte // an inner node made of message bits: '110'
+}
+
+var (
+	// sakuraTurboShake codes nodes for TurboSHAKE, which has no suffix of
+	// its own.
+	sakuraTurboShake = sakuraCoding{single: 0x07, final: 0x06, leaf: 0x0b}
+
+	// sakuraRawShake codes nodes for RawSHAKE, which appends '11' to the
+	// coded node. A single node thus ends with '1111', making it a SHAKE
+	// digest of the message.
+	sakuraRawShake = sakuraCoding{single: 0x1f, final: 0x1e, leaf: 0x3b}
+)
+
+// sakuraHopSeparator follows the message hop in the final node of a tree.
+// It is the frame bits '11' of a message hop to be followed by a chaining
+// hop, padded with zeros to 64 bits so that the chaining values are
+// aligned.
+var sakuraHopSeparator = [8]byte{0x03}
+
+// appendSakuraChainingHop appends the coding of a chaining hop of n chaining
+// values, which follows the values themselves in the final node:
+// length_encode(n) and the two bytes marking the values as unpadded.
+func appendSakuraChainingHop(b []byte, n uint64) []byte {
+	b = append(b, lengthEncode(n)...)
+	return append(b, 0xff, 0xff)
+}
+
+// NewSakuraShake128 creates a new ShakeHash for the Sakura tree hash built
+// on RawSHAKE128 with kangaroo hopping and leaves of chunkSize bytes. A
+// message of at most chunkSize bytes fits in a single node, and its digest
+// is its SHAKE128 digest. Its generic security strength is 128 bits; Sum
+// returns 32 bytes of output. NewSakuraShake128 panics if chunkSize is not
+// positive.
+//
+// Large writes have their leaves hashed concurrently on up to GOMAXPROCS
+// goroutines.
+func NewSakuraShake128(chunkSize int) ShakeHash {
+	if chunkSize <= 0 {
+		panic("keccak: non-positive Sakura chunk size")
+	}
+	return newSakuraTree(sakuraRawShake, chunkSize, rateK256, 32, 32, 0)
+}
+
+// NewSakuraShake256 creates a new ShakeHash for the Sakura tree hash built
+// on RawSHAKE256 with kangaroo hopping and leaves of chunkSize bytes. A
+// message of at most chunkSize bytes fits in a single node, and its digest
+// is its SHAKE256 digest. Its generic security strength is 256 bits; Sum
+// returns 64 bytes of output. NewSakuraShake256 panics if chunkSize is not
+// positive.
+//
+// Large writes have their leaves hashed concurrently on up to GOMAXPROCS
+// goroutines.
+func NewSakuraShake256(chunkSize int) ShakeHash {
+	if chunkSize <= 0 {
+		panic("keccak: non-positive Sakura chunk size")
+	}
+	return newSakuraTree(sakuraRawShake, chunkSize, rateK512, 64, 64, 0)
+}
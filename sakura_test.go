@@ -0,0 +1,87 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors generated by _gen/sakura.py.
+func TestSakuraShakeVectors(t *testing.T) {
+	for _, tc := range []struct {
+		new        func(int) ShakeHash
+		n, chunk   int
+		want, name string
+	}{
+		{NewSakuraShake128, 0, 1024, "7f9c2ba4e88f827d616045507605853ed73b8093f6efbc88eb1a6eacfa66ef26", "128"},
+		{NewSakuraShake128, 1024, 1024, "b4347102639420d3f094d275cc1311e91cab7e047cf624679f20b81f5c9e2a9c", "128"},
+		{NewSakuraShake128, 1025, 1024, "79a51d162e9c0c72b5a5e19c268bf77b52925b122d7efa66cb5e35420d4fe272", "128"},
+		{NewSakuraShake128, 5000, 1024, "9463cb836ae59d6dc706abc9a1f7958fb8c4ad0d2bbd14541f3f1714657c9fa8", "128"},
+		{NewSakuraShake128, 20000, 8192, "86feef073059af18dd8af2c1284d2a271e437da5e5c1ae0f5275bf132f2fa6e5", "128"},
+		{NewSakuraShake256, 0, 1024, "46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200cb05019d67b592f6fc821c49479ab48640292eacb3b7c4be", "256"},
+		{NewSakuraShake256, 1024, 1024, "9d780b699069ff9c378acd8857081da4efe44787ead6b5c92d8213445d4b1ebaf8f18ee78ae5c80640470731ab70162961625d053467abafe60dcb7950fc84bd", "256"},
+		{NewSakuraShake256, 1025, 1024, "d6e3ad42deb1e963fd9882ade1c8b58b651a290b1cbd54fc1db517d9bcc9fd97f2a61eba57fb6917ad3ea32ad4bd72924aecb54bfc3997f6138abf0abb2e6a39", "256"},
+		{NewSakuraShake256, 5000, 1024, "f9c3b795c807ea0214ecd92bb5eb1d5bbef68b63fd2d3cbdd77fd84cf7add944c97aacb2715fc5bfa6ef0b191999a2c3304f5720f8f4ed8002b22452ac0b72f0", "256"},
+		{NewSakuraShake256, 20000, 8192, "2088a9704297d15f716f9ab9f4b0c90c30795448461e3131c96b80f2993e61611ede0662c2c368ebfdbe2f257e30fc35bc8432cb33ff762f96da39018345e2ac", "256"},
+	} {
+		h := tc.new(tc.chunk)
+		h.Write(ptn(tc.n))
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("SakuraShake%s(%d bytes, chunk %d) = %s, want %s", tc.name, tc.n, tc.chunk, got, tc.want)
+		}
+	}
+}
+
+// A message that fits in a single node is hashed to its SHAKE digest.
+func TestSakuraShakeSingleNode(t *testing.T) {
+	msg := ptn(1000)
+	for _, tc := range []struct {
+		tree, shake ShakeHash
+	}{
+		{NewSakuraShake128(len(msg)), NewShake128()},
+		{NewSakuraShake256(len(msg)), NewShake256()},
+	} {
+		tc.tree.Write(msg)
+		tc.shake.Write(msg)
+		got, want := make([]byte, 300), make([]byte, 300)
+		tc.tree.Read(got)
+		tc.shake.Read(want)
+		if !bytes.Equal(got, want) {
+			t.Errorf("single node = %x, want %x", got, want)
+		}
+	}
+}
+
+// The Sakura tree with TurboSHAKE coding and no customization is
+// KangarooTwelve with an empty customization string, whose suffix is a
+// single zero byte.
+func TestSakuraTurboShakeIsKangarooTwelve(t *testing.T) {
+	for _, n := range []int{0, 100, 8191, 8192, 8193, 3*8192 + 5} {
+		msg := ptn(n)
+		k := newSakuraTree(sakuraTurboShake, k12ChunkSize, rateK256, 32, 32, k12Rounds)
+		k.Write(msg)
+		k.Write([]byte{0})
+		want := NewKangarooTwelve(nil)
+		want.Write(msg)
+		if got, want := k.Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%d bytes: Sakura tree = %x, want %x", n, got, want)
+		}
+	}
+}
+
+func TestSakuraShakeSmallWrites(t *testing.T) {
+	msg := ptn(5000)
+	want := NewSakuraShake128(1024)
+	want.Write(msg)
+	h := NewSakuraShake128(1024)
+	for i := 0; i < len(msg); i += 7 {
+		h.Write(msg[i:min(i+7, len(msg))])
+	}
+	if got, want := h.Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+		t.Errorf("small writes = %x, want %x", got, want)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file hashes the signatures of Solidity functions and events, which
+// identify them in the calldata and logs of the ABI by the Keccak-256 digest
+// of their canonical form, or its first 4 bytes.
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Selector returns the 4-byte selector of a Solidity function, the first 4
+// bytes of the Keccak-256 digest of its canonical signature, such as
+// a9059cbb for "transfer(address,uint256)". The signature is canonicalized
+// by CanonicalSignature first, so "transfer(address to, uint amount)" has
+// the same selector. Selector panics if the signature is invalid; use
+// CanonicalSignature to check signatures from untrusted input.
+func Selector(signature string) (selector [4]byte) {
+	digest := Sum256([]byte(mustCanonicalSignature(signature)))
+	copy(selector[:], digest[:])
+	return
+}
+
+// EventTopic returns the topic of a Solidity event, the Keccak-256 digest
+// of its canonical signature, which is the first topic of the logs it
+// emits unless it is anonymous. The signature is canonicalized as by
+// Selector, so "Transfer(address indexed from, address indexed to, uint256
+// value)" is accepted. EventTopic panics if the signature is invalid.
+func EventTopic(signature string) [32]byte {
+	return Sum256([]byte(mustCanonicalSignature(signature)))
+}
+
+// InterfaceID returns the ERC-165 identifier of the interface made of the
+// functions with the given signatures: the XOR of their selectors. As in
+// Solidity, the functions inherited from other interfaces and
+// supportsInterface itself are usually not part of it. InterfaceID panics
+// if a signature is invalid, as Selector does.
+func InterfaceID(signatures ...string) (id [4]byte) {
+	for _, sig := range signatures {
+		s := Selector(sig)
+		for i := range id {
+			id[i] ^= s[i]
+		}
+	}
+	return
+}
+
+func mustCanonicalSignature(signature string) string {
+	canonical, err := CanonicalSignature(signature)
+	if err != nil {
+		panic(err)
+	}
+	return canonical
+}
+
+// CanonicalSignature returns the canonical form of a Solidity signature:
+// the name and the types of the parameters between parentheses, separated
+// by commas, with no spaces. Parameter names, data locations and the
+// indexed and payable keywords are dropped, and the types uint, int, byte,
+// fixed and ufixed are replaced by the types they alias. Tuples are written
+// as the types of their components in parentheses, with or without the
+// tuple keyword, and arrays as the type followed by [] or [k].
+//
+// CanonicalSignature returns an error if the signature is not a valid
+// identifier followed by a parameter list, or if a type is not an ABI type.
+func CanonicalSignature(signature string) (string, error) {
+	p := signatureParser{s: signature}
+	var b strings.Builder
+	name := p.next()
+	if !isIdentifier(name) {
+		return "", p.errorf("missing name")
+	}
+	b.WriteString(name)
+	if err := p.params(&b); err != nil {
+		return "", err
+	}
+	if t := p.next(); t != "" {
+		return "", p.errorf("unexpected %q after the parameters", t)
+	}
+	return b.String(), nil
+}
+
+// signatureParser splits a signature into tokens: the punctuation ( ) [ ]
+// and the comma, and runs of identifier characters, between which spaces
+// are skipped.
+type signatureParser struct {
+	s   string
+	pos int
+}
+
+func (p *signatureParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("keccak: invalid signature %q: %s", p.s, fmt.Sprintf(format, args...))
+}
+
+// next returns the next token, or "" at the end of the signature.
+func (p *signatureParser) next() string {
+	t := p.peek()
+	p.pos += len(t)
+	return t
+}
+
+// peek returns the next token without consuming it.
+func (p *signatureParser) peek() string {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	end := p.pos
+	for end < len(p.s) && isIdentifierByte(p.s[end]) {
+		end++
+	}
+	if end == p.pos && end < len(p.s) {
+		end++
+	}
+	return p.s[p.pos:end]
+}
+
+// params writes a parameter list in parentheses.
+func (p *signatureParser) params(b *strings.Builder) error {
+	if t := p.next(); t != "(" {
+		return p.errorf("expected ( and found %q", t)
+	}
+	b.WriteByte('(')
+	if p.peek() == ")" {
+		p.next()
+		b.WriteByte(')')
+		return nil
+	}
+	for {
+		if err := p.param(b); err != nil {
+			return err
+		}
+		switch t := p.next(); t {
+		case ",":
+			b.WriteByte(',')
+		case ")":
+			b.WriteByte(')')
+			return nil
+		default:
+			return p.errorf("expected , or ) and found %q", t)
+		}
+	}
+}
+
+// param writes the type of a parameter, dropping its keywords and name.
+func (p *signatureParser) param(b *strings.Builder) error {
+	if err := p.typ(b); err != nil {
+		return err
+	}
+	named := false
+	for t := p.peek(); isIdentifier(t); t = p.peek() {
+		p.next()
+		if named {
+			return p.errorf("unexpected %q after a parameter name", t)
+		}
+		switch t {
+		case "indexed", "memory", "calldata", "storage":
+		default:
+			named = true
+		}
+	}
+	return nil
+}
+
+// typ writes a type, with its array dimensions.
+func (p *signatureParser) typ(b *strings.Builder) error {
+	switch t := p.peek(); t {
+	case "tuple":
+		p.next()
+		fallthrough
+	case "(":
+		if err := p.params(b); err != nil {
+			return err
+		}
+	default:
+		p.next()
+		canonical, ok := elementaryType(t)
+		if !ok {
+			return p.errorf("unknown type %q", t)
+		}
+		b.WriteString(canonical)
+		if canonical == "address" && p.peek() == "payable" {
+			p.next()
+		}
+	}
+	for p.peek() == "[" {
+		p.next()
+		b.WriteByte('[')
+		if t := p.next(); t != "]" {
+			if n, err := strconv.ParseUint(t, 10, 64); err != nil || n == 0 || t[0] == '0' {
+				return p.errorf("invalid array length %q", t)
+			}
+			b.WriteString(t)
+			if t := p.next(); t != "]" {
+				return p.errorf("expected ] and found %q", t)
+			}
+		}
+		b.WriteByte(']')
+	}
+	return nil
+}
+
+// elementaryType returns the canonical name of an elementary ABI type, and
+// whether t is one.
+func elementaryType(t string) (string, bool) {
+	switch t {
+	case "address", "bool", "string", "bytes", "function":
+		return t, true
+	case "uint", "int":
+		return t + "256", true
+	case "byte":
+		return "bytes1", true
+	case "fixed", "ufixed":
+		return t + "128x18", true
+	}
+	switch {
+	case strings.HasPrefix(t, "uint"):
+		return t, isSize(t[4:], 8, 256, 8)
+	case strings.HasPrefix(t, "int"):
+		return t, isSize(t[3:], 8, 256, 8)
+	case strings.HasPrefix(t, "bytes"):
+		return t, isSize(t[5:], 1, 32, 1)
+	case strings.HasPrefix(t, "ufixed"):
+		m, n, ok := strings.Cut(t[6:], "x")
+		return t, ok && isSize(m, 8, 256, 8) && isSize(n, 0, 80, 1)
+	case strings.HasPrefix(t, "fixed"):
+		m, n, ok := strings.Cut(t[5:], "x")
+		return t, ok && isSize(m, 8, 256, 8) && isSize(n, 0, 80, 1)
+	}
+	return "", false
+}
+
+// isSize reports whether s is the decimal form, with no leading zeros, of
+// a multiple of step between lo and hi.
+func isSize(s string, lo, hi, step int) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && strconv.Itoa(n) == s && lo <= n && n <= hi && n%step == 0
+}
+
+func isIdentifierByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '$'
+}
+
+// isIdentifier reports whether t is a Solidity identifier.
+func isIdentifier(t string) bool {
+	return t != "" && isIdentifierByte(t[0]) && (t[0] < '0' || t[0] > '9')
+}
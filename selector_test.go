@@ -0,0 +1,125 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestSelector(t *testing.T) {
+	for _, tc := range []struct{ sig, want string }{
+		{"transfer(address,uint256)", "a9059cbb"},
+		{"transfer(address to, uint amount)", "a9059cbb"},
+		{"balanceOf(address)", "70a08231"},
+		{"approve(address,uint256)", "095ea7b3"},
+		{"transferFrom(address,address,uint256)", "23b872dd"},
+		{"totalSupply()", "18160ddd"},
+	} {
+		got := Selector(tc.sig)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("Selector(%q) = %x, want %s", tc.sig, got, tc.want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Selector of an invalid signature did not panic")
+		}
+	}()
+	Selector("transfer(address,uint257)")
+}
+
+func TestCanonicalSignature(t *testing.T) {
+	for _, tc := range []struct{ sig, want string }{
+		{"f()", "f()"},
+		{" f ( ) ", "f()"},
+		{"f(uint,int,byte,fixed,ufixed)", "f(uint256,int256,bytes1,fixed128x18,ufixed128x18)"},
+		{"f(uint8,int256,bytes32,bytes,string,bool,function,fixed8x0,ufixed256x80)", "f(uint8,int256,bytes32,bytes,string,bool,function,fixed8x0,ufixed256x80)"},
+		{"f(address payable to, bytes calldata data, string memory s)", "f(address,bytes,string)"},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", "Transfer(address,address,uint256)"},
+		{"f(uint[] a, uint[2][] b, (uint, address)[3] c)", "f(uint256[],uint256[2][],(uint256,address)[3])"},
+		{"f(tuple(uint256 x, (bool, bytes4) y) t)", "f((uint256,(bool,bytes4)))"},
+		{"$_f9(uint256 $x)", "$_f9(uint256)"},
+	} {
+		got, err := CanonicalSignature(tc.sig)
+		if err != nil || got != tc.want {
+			t.Errorf("CanonicalSignature(%q) = %q, %v, want %q", tc.sig, got, err, tc.want)
+		}
+	}
+
+	for _, sig := range []string{
+		"",
+		"f",
+		"()",
+		"9f()",
+		"f(",
+		"f(uint256",
+		"f(uint256,)",
+		"f(,uint256)",
+		"f(uint257)",
+		"f(uint0)",
+		"f(uint08)",
+		"f(int7)",
+		"f(bytes0)",
+		"f(bytes33)",
+		"f(fixed128x81)",
+		"f(fixed128)",
+		"f(ufixed7x1)",
+		"f(foo)",
+		"f(uint256[0])",
+		"f(uint256[01])",
+		"f(uint256[x])",
+		"f(uint256[2)",
+		"f(uint256 a b)",
+		"f(uint256 a indexed)",
+		"f(uint256) external",
+		"f(uint256);",
+		"f(uint256-1)",
+	} {
+		if got, err := CanonicalSignature(sig); err == nil {
+			t.Errorf("CanonicalSignature(%q) = %q, want an error", sig, got)
+		}
+	}
+}
+
+func TestEventTopic(t *testing.T) {
+	for _, tc := range []struct{ sig, want string }{
+		{"Transfer(address,address,uint256)", "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		{"Approval(address indexed owner, address indexed spender, uint value)", "8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"},
+	} {
+		got := EventTopic(tc.sig)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("EventTopic(%q) = %x, want %s", tc.sig, got, tc.want)
+		}
+	}
+}
+
+func TestInterfaceID(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		sigs []string
+		want string
+	}{
+		{"ERC-165", []string{"supportsInterface(bytes4)"}, "01ffc9a7"},
+		{"ERC-721", []string{
+			"balanceOf(address)",
+			"ownerOf(uint256)",
+			"safeTransferFrom(address,address,uint256,bytes)",
+			"safeTransferFrom(address,address,uint256)",
+			"transferFrom(address,address,uint256)",
+			"approve(address,uint256)",
+			"setApprovalForAll(address,bool)",
+			"getApproved(uint256)",
+			"isApprovedForAll(address,address)",
+		}, "80ac58cd"},
+		{"empty", nil, "00000000"},
+	} {
+		got := InterfaceID(tc.sigs...)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("InterfaceID of %s = %x, want %s", tc.name, got, tc.want)
+		}
+	}
+}
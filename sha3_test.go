@@ -0,0 +1,71 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"encoding"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func TestSHA3_256ABC(t *testing.T) {
+	// FIPS 202 example
+	expected := "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"
+	h := New256()
+	h.Write([]byte("abc"))
+	if got := hex.EncodeToString(h.Sum(nil)); got != expected {
+		t.Errorf("SHA3-256(\"abc\") = %s, want %s", got, expected)
+	}
+}
+
+func TestSHA3MatchesStdlib(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		ours func() hash.Hash
+		std  func() *sha3.SHA3
+	}{
+		{"SHA3-224", New224, sha3.New224},
+		{"SHA3-256", New256, sha3.New256},
+		{"SHA3-384", New384, sha3.New384},
+		{"SHA3-512", New512, sha3.New512},
+	} {
+		for _, n := range []int{0, 1, 71, 72, 135, 136, 1000} {
+			msg := ptn(n)
+			h, s := tc.ours(), tc.std()
+			h.Write(msg)
+			s.Write(msg)
+			if !bytes.Equal(h.Sum(nil), s.Sum(nil)) {
+				t.Errorf("%s(ptn(%d)) differs from crypto/sha3", tc.name, n)
+			}
+		}
+	}
+}
+
+func TestSHA3MarshalStdlib(t *testing.T) {
+	// The marshaled state has the same format as the standard library's.
+	h := New256()
+	h.Write(ptn(200))
+	state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := sha3.New256()
+	if err := s.UnmarshalBinary(state); err != nil {
+		t.Fatal(err)
+	}
+	h.Write([]byte("tail"))
+	s.Write([]byte("tail"))
+	if !bytes.Equal(h.Sum(nil), s.Sum(nil)) {
+		t.Error("state restored by crypto/sha3 gives a different digest")
+	}
+
+	// A SHA-3 state cannot be restored into a legacy Keccak hash.
+	if err := NewLegacyKeccak256().(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err == nil {
+		t.Error("SHA3-256 state accepted by Keccak-256")
+	}
+}
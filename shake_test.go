@@ -0,0 +1,317 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"testing"
+)
+
+func TestShake128Empty(t *testing.T) {
+	// SHAKE128 of empty input, 32 bytes of output (FIPS 202 examples)
+	expected := "7f9c2ba4e88f827d616045507605853ed73b8093f6efbc88eb1a6eacfa66ef26"
+	out := make([]byte, 32)
+	ShakeSum128(out, nil)
+	if got := hex.EncodeToString(out); got != expected {
+		t.Errorf("SHAKE128('') = %s, want %s", got, expected)
+	}
+}
+
+func TestShake256Empty(t *testing.T) {
+	// SHAKE256 of empty input, 64 bytes of output (FIPS 202 examples)
+	expected := "46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200cb05019d67b592f6fc821c49479ab48640292eacb3b7c4be"
+	out := make([]byte, 64)
+	ShakeSum256(out, nil)
+	if got := hex.EncodeToString(out); got != expected {
+		t.Errorf("SHAKE256('') = %s, want %s", got, expected)
+	}
+}
+
+func TestShakeMatchesStdlib(t *testing.T) {
+	msg := make([]byte, 1000)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	for _, tc := range []struct {
+		name string
+		ours ShakeHash
+		std  *sha3.SHAKE
+	}{
+		{"SHAKE128", NewShake128(), sha3.NewSHAKE128()},
+		{"SHAKE256", NewShake256(), sha3.NewSHAKE256()},
+	} {
+		for _, n := range []int{0, 1, 135, 136, 167, 168, 169, 1000} {
+			tc.ours.Reset()
+			tc.std.Reset()
+			tc.ours.Write(msg[:n])
+			tc.std.Write(msg[:n])
+
+			// Squeeze in uneven pieces to exercise the buffer.
+			got := make([]byte, 500)
+			for i := 0; i < len(got); i += 7 {
+				tc.ours.Read(got[i:min(i+7, len(got))])
+			}
+			want := make([]byte, 500)
+			tc.std.Read(want)
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s(%d bytes) mismatch with crypto/sha3", tc.name, n)
+			}
+		}
+	}
+}
+
+func TestShakeClone(t *testing.T) {
+	h := NewShake256()
+	h.Write([]byte("hello"))
+	c := h.Clone()
+
+	a := make([]byte, 100)
+	b := make([]byte, 100)
+	h.Read(a)
+	c.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("clone produced different output")
+	}
+}
+
+func TestShakeSum(t *testing.T) {
+	// Sum produces the minimum-length output without consuming the state.
+	h := NewShake128()
+	h.Write([]byte("abc"))
+	sum := h.Sum(nil)
+
+	out := make([]byte, 32)
+	h.Read(out)
+	if !bytes.Equal(sum, out) {
+		t.Errorf("Sum = %x, Read = %x", sum, out)
+	}
+}
+
+func TestShakeMarshalUnmarshal(t *testing.T) {
+	h1 := NewShake128()
+	h1.Write([]byte("hello"))
+	data, err := h1.(*state).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h2 := NewShake128()
+	if err := h2.(*state).UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewLegacyKeccak256().(*state).UnmarshalBinary(data); err == nil {
+		t.Error("Keccak-256 accepted a SHAKE state")
+	}
+
+	a := make([]byte, 64)
+	b := make([]byte, 64)
+	h1.Read(a)
+	h2.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("marshaled/unmarshaled SHAKE produced different output")
+	}
+}
+
+func TestCShakeSamples(t *testing.T) {
+	// Samples from NIST's cSHAKE_samples.pdf.
+	data := []byte{0x00, 0x01, 0x02, 0x03}
+	for _, tc := range []struct {
+		newFunc func(N, S []byte) ShakeHash
+		size    int
+		want    string
+	}{
+		{NewCShake128, 32, "c1c36925b6409a04f1b504fcbca9d82b4017277cb5ed2b2065fc1d3814d5aaf5"},
+		{NewCShake256, 64, "d008828e2b80ac9d2218ffee1d070c48b8e4c87bff32c9699d5b6896eee0edd164020e2be0560858d9c00c037e34a96937c561a74c412bb4c746469527281c8c"},
+	} {
+		h := tc.newFunc(nil, []byte("Email Signature"))
+		h.Write(data)
+		out := make([]byte, tc.size)
+		h.Read(out)
+		if got := hex.EncodeToString(out); got != tc.want {
+			t.Errorf("cSHAKE = %s, want %s", got, tc.want)
+		}
+	}
+}
+
+func TestCShakeMatchesStdlib(t *testing.T) {
+	N, S := []byte("function"), bytes.Repeat([]byte("customization"), 20)
+	msg := bytes.Repeat([]byte{0xa3}, 500)
+	for _, tc := range []struct {
+		name string
+		ours ShakeHash
+		std  *sha3.SHAKE
+	}{
+		{"cSHAKE128", NewCShake128(N, S), sha3.NewCSHAKE128(N, S)},
+		{"cSHAKE256", NewCShake256(N, S), sha3.NewCSHAKE256(N, S)},
+		{"cSHAKE128 (empty N, S)", NewCShake128(nil, nil), sha3.NewCSHAKE128(nil, nil)},
+	} {
+		tc.ours.Write(msg)
+		tc.std.Write(msg)
+		got := make([]byte, 300)
+		want := make([]byte, 300)
+		tc.ours.Read(got)
+		tc.std.Read(want)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s mismatch with crypto/sha3", tc.name)
+		}
+	}
+}
+
+func TestCShakeResetAndClone(t *testing.T) {
+	h := NewCShake256([]byte("N"), []byte("S"))
+	want := make([]byte, 64)
+	h.Write([]byte("msg"))
+	h.Clone().Read(want)
+
+	h.Write([]byte("garbage"))
+	h.Reset()
+	h.Write([]byte("msg"))
+	got := make([]byte, 64)
+	h.Read(got)
+	if !bytes.Equal(got, want) {
+		t.Errorf("after Reset, cSHAKE256 = %x, want %x", got, want)
+	}
+}
+
+func TestCShakeMarshalUnmarshal(t *testing.T) {
+	h1 := NewCShake128([]byte("N"), []byte("S"))
+	h1.Write([]byte("hello"))
+	data, err := h1.(*cshakeState).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h2 := NewCShake128([]byte("other"), nil)
+	if err := h2.(*cshakeState).UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	h1.Reset()
+	h2.Reset()
+	a := make([]byte, 32)
+	b := make([]byte, 32)
+	h1.Read(a)
+	h2.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("unmarshaled cSHAKE did not restore the customization")
+	}
+}
+
+var (
+	_ hash.XOF = ShakeHash(nil)
+	_ hash.XOF = (*ParallelHash)(nil)
+)
+
+// readXOF hashes msg with x and reads n bytes of output through the
+// standard library's interface only.
+func readXOF(x hash.XOF, msg []byte, n int) []byte {
+	x.Reset()
+	x.Write(msg)
+	out := make([]byte, n)
+	x.Read(out)
+	return out
+}
+
+func TestShakeHashXOF(t *testing.T) {
+	msg := []byte("The quick brown fox jumps over the lazy dog")
+	for _, tc := range []struct {
+		name      string
+		got, want hash.XOF
+	}{
+		{"SHAKE128", NewShake128(), sha3.NewSHAKE128()},
+		{"SHAKE256", NewShake256(), sha3.NewSHAKE256()},
+		{"cSHAKE128", NewCShake128([]byte("N"), []byte("S")), sha3.NewCSHAKE128([]byte("N"), []byte("S"))},
+		{"cSHAKE256", NewCShake256([]byte("N"), []byte("S")), sha3.NewCSHAKE256([]byte("N"), []byte("S"))},
+	} {
+		if tc.got.BlockSize() != tc.want.BlockSize() {
+			t.Errorf("%s: BlockSize = %d, want %d", tc.name, tc.got.BlockSize(), tc.want.BlockSize())
+		}
+		// Write some garbage first to check that Reset is honored.
+		tc.got.Write([]byte("garbage"))
+		if got, want := readXOF(tc.got, msg, 200), readXOF(tc.want, msg, 200); !bytes.Equal(got, want) {
+			t.Errorf("%s: output = %x, want %x", tc.name, got, want)
+		}
+	}
+}
+
+// Squeezing reads of any size and alignment matches squeezing the same
+// output in one go.
+func TestShakeReadSizes(t *testing.T) {
+	want := make([]byte, 10000)
+	ShakeSum128(want, []byte("seed"))
+	for _, size := range []int{1, 7, 167, 168, 169, 1000, 4096} {
+		h := NewShake128()
+		h.Write([]byte("seed"))
+		got := make([]byte, 0, len(want))
+		for len(got) < len(want) {
+			buf := make([]byte, min(size, len(want)-len(got)))
+			h.Read(buf)
+			got = append(got, buf...)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("reads of %d bytes differ from a single read", size)
+		}
+	}
+}
+
+func BenchmarkShake128Read(b *testing.B) {
+	out := make([]byte, 1<<16)
+	b.SetBytes(int64(len(out)))
+	h := NewShake128()
+	for i := 0; i < b.N; i++ {
+		h.Read(out)
+	}
+}
+
+// The squeezing of whole blocks on big-endian hosts converts the state to
+// lanes explicitly, so it can be exercised on any host.
+func TestShakeReadBigEndianPath(t *testing.T) {
+	squeeze := func(h ShakeHash) []byte {
+		h.Write([]byte("seed"))
+		out := make([]byte, 2000)
+		h.Read(out[:7])
+		h.Read(out[7:1000])
+		h.Read(out[1000:])
+		return out
+	}
+	for _, tc := range []struct {
+		name string
+		new  func() ShakeHash
+	}{
+		{"SHAKE128", NewShake128},
+		{"TurboSHAKE128", func() ShakeHash { return NewTurboShake128(0x1f) }},
+	} {
+		want := squeeze(tc.new())
+		func() {
+			defer func(b bool) { isBigEndian = b }(isBigEndian)
+			isBigEndian = true
+			if got := squeeze(tc.new()); !bytes.Equal(got, want) {
+				t.Errorf("%s: big-endian squeeze = %x, want %x", tc.name, got, want)
+			}
+		}()
+	}
+}
+
+// BenchmarkShake128ReadBigEndian compares, through the big-endian path on
+// any host, reads of one byte less than a block, which permute the state
+// in place and so convert it to lanes and back for every block, with large
+// reads, which squeeze whole blocks straight from the lanes.
+func BenchmarkShake128ReadBigEndian(b *testing.B) {
+	for _, size := range []int{rateK256 - 1, 64 * rateK256} {
+		b.Run(fmt.Sprint(size), func(b *testing.B) {
+			defer func(v bool) { isBigEndian = v }(isBigEndian)
+			isBigEndian = true
+			out := make([]byte, size)
+			b.SetBytes(int64(size))
+			h := NewShake128()
+			for i := 0; i < b.N; i++ {
+				h.Read(out)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+)
+
+const shakeStreamKeySize = 32
+
+// ShakeStream is a stream cipher whose keystream is the output of
+// SHAKE256(key || nonce). It implements cipher.Stream, and io.Seeker to
+// move within the keystream.
+//
+// ShakeStream provides confidentiality only; messages should also be
+// authenticated, for example with KMAC.
+type ShakeStream struct {
+	// init is the sponge after absorbing the key and nonce, from which
+	// the keystream is squeezed again when seeking backwards.
+	init   state
+	d      state
+	offset int64
+}
+
+// NewShakeStream returns a stream cipher keyed with key, which must be 32
+// bytes long. The nonce may have any length, but a nonce must never be
+// reused with the same key.
+func NewShakeStream(key, nonce []byte) (*ShakeStream, error) {
+	if len(key) != shakeStreamKeySize {
+		return nil, errors.New("keccak: ShakeStream key must be 32 bytes")
+	}
+	s := &ShakeStream{init: state{rate: rateK512, outputLen: 64, dsbyte: dsbyteShake}}
+	s.init.Write(key)
+	s.init.Write(nonce)
+	s.init.padAndPermute()
+	s.d = s.init
+	return s, nil
+}
+
+// XORKeyStream XORs each byte in src with a byte of the keystream and
+// writes the result to dst. Dst and src must overlap entirely or not at
+// all, and dst must be at least as long as src.
+func (s *ShakeStream) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("keccak: output smaller than input")
+	}
+	if inexactOverlap(dst[:len(src)], src) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	s.offset += int64(len(src))
+	for len(src) > 0 {
+		if s.d.n == s.d.rate {
+			s.d.permute()
+		}
+		x := subtle.XORBytes(dst, src, s.d.a[s.d.n:s.d.rate])
+		s.d.n += x
+		dst, src = dst[x:], src[x:]
+	}
+}
+
+// Seek sets the position in the keystream for the next call to
+// XORKeyStream, interpreted according to whence as with io.Seeker. The
+// keystream has no end, so io.SeekEnd is not supported. Seeking costs one
+// permutation for every 136 bytes skipped, counted from the start of the
+// keystream when seeking backwards.
+func (s *ShakeStream) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.offset
+	default:
+		return 0, errors.New("keccak: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("keccak: negative position")
+	}
+
+	skip := offset - s.offset
+	if skip < 0 {
+		s.d = s.init
+		skip = offset
+	}
+	for skip > 0 {
+		if s.d.n == s.d.rate {
+			s.d.permute()
+		}
+		x := int(min(skip, int64(s.d.rate-s.d.n)))
+		s.d.n += x
+		skip -= int64(x)
+	}
+	s.offset = offset
+	return offset, nil
+}
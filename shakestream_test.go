@@ -0,0 +1,63 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/sha3"
+	"io"
+	"testing"
+)
+
+var _ cipher.Stream = (*ShakeStream)(nil)
+
+func TestShakeStream(t *testing.T) {
+	key, nonce := ptn(32), []byte("nonce")
+	want := sha3.SumSHAKE256(append(bytes.Clone(key), nonce...), 1000)
+
+	s, err := NewShakeStream(key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// XORing zeros gives the keystream, in chunks of any size.
+	got := make([]byte, 0, 1000)
+	for _, n := range []int{1, 135, 136, 137, 591} {
+		buf := make([]byte, n)
+		s.XORKeyStream(buf, buf)
+		got = append(got, buf...)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("keystream differs from SHAKE256(key || nonce)")
+	}
+
+	for _, pos := range []int64{500, 0, 136, 999, 272} {
+		if n, err := s.Seek(pos, io.SeekStart); err != nil || n != pos {
+			t.Fatalf("Seek(%d) = %d, %v", pos, n, err)
+		}
+		buf := make([]byte, 1)
+		s.XORKeyStream(buf, buf)
+		if buf[0] != want[pos] {
+			t.Errorf("keystream byte after Seek(%d) = %#x, want %#x", pos, buf[0], want[pos])
+		}
+	}
+
+	if n, err := s.Seek(-10, io.SeekCurrent); err != nil || n != 263 {
+		t.Fatalf("Seek(-10, SeekCurrent) = %d, %v", n, err)
+	}
+	if _, err := s.Seek(-1, io.SeekStart); err == nil {
+		t.Error("Seek accepted a negative position")
+	}
+	if _, err := s.Seek(0, io.SeekEnd); err == nil {
+		t.Error("Seek accepted io.SeekEnd")
+	}
+}
+
+func TestShakeStreamKeySize(t *testing.T) {
+	if _, err := NewShakeStream(make([]byte, 16), nil); err == nil {
+		t.Error("accepted a 16-byte key")
+	}
+}
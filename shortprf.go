@@ -0,0 +1,51 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides a keyed PRF for short inputs, in the spirit of
+// SipHash: a fixed-size key, a fixed-size output and a single call of the
+// permutation for messages of up to ShortPRFMaxSingleBlock bytes.
+//
+// The PRF is TurboSHAKE128 of the key followed by the message, with a
+// domain separation byte of its own. Prefixing the key to the input of a
+// sponge gives a secure PRF as long as the permutation behaves like a
+// random one, with a generic bound of about q²/2^c for q queries and a
+// capacity of c = 256 bits. The 12 rounds of Keccak-p[1600] are those of
+// TurboSHAKE and KangarooTwelve, and the best known attacks on keyed
+// Keccak reach well below 12 rounds. The security is thus limited by the
+// 128-bit key and output: recovering the key takes about 2^128 work, and
+// forging a tag about 2^128 guesses.
+
+// dsbyteShortPRF is the TurboSHAKE domain separation byte of ShortPRF. It
+// is distinct from the values used by KangarooTwelve and by SHAKE.
+const dsbyteShortPRF = 0x0c
+
+const (
+	// ShortPRFKeySize is the size of a ShortPRF key in bytes.
+	ShortPRFKeySize = 16
+
+	// ShortPRFSize is the size of a ShortPRF output in bytes.
+	ShortPRFSize = 16
+
+	// ShortPRFMaxSingleBlock is the largest message for which ShortPRF
+	// applies the permutation only once.
+	ShortPRFMaxSingleBlock = rateK256 - ShortPRFKeySize - 1
+)
+
+// ShortPRF returns the 128-bit PRF of msg under key, computed with 12-round
+// Keccak-p[1600]. It is intended for authenticating short messages such as
+// network frames, and for keyed hashing of short inputs in hash tables.
+// Messages of any length are accepted, but ShortPRF is at its fastest with
+// messages of at most ShortPRFMaxSingleBlock bytes.
+//
+// ShortPRF is TurboSHAKE128(key || msg, 0x0c) truncated to 16 bytes, and
+// does not allocate.
+func ShortPRF(key *[ShortPRFKeySize]byte, msg []byte) (out [ShortPRFSize]byte) {
+	d := state{rate: rateK256, dsbyte: dsbyteShortPRF, rounds: k12Rounds}
+	d.Write(key[:])
+	d.Write(msg)
+	d.Read(out[:])
+	return
+}
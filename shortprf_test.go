@@ -0,0 +1,66 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors generated by _gen/shortprf.py, with the key 00 01 ... 0f and
+// messages 00 01 02 ...
+func TestShortPRFVectors(t *testing.T) {
+	var key [ShortPRFKeySize]byte
+	for i := range key {
+		key[i] = byte(i)
+	}
+	for _, tc := range []struct {
+		n    int
+		want string
+	}{
+		{0, "07e51defd3c3ef17d7cb01c690f4af45"},
+		{15, "e6d8c27e494f118c248e3d3e4b1e55bb"},
+		{64, "fbe40e3faaf6df96d5f299c0028019a1"},
+		{ShortPRFMaxSingleBlock + 1, "09d4b2391f4b4a0b250fe99446e7e26d"},
+		{200, "e00a4ec5f78f17aebd05b0f2cbc6ce08"},
+	} {
+		msg := make([]byte, tc.n)
+		for i := range msg {
+			msg[i] = byte(i)
+		}
+		got := ShortPRF(&key, msg)
+		if hex.EncodeToString(got[:]) != tc.want {
+			t.Errorf("ShortPRF(%d bytes) = %x, want %s", tc.n, got, tc.want)
+		}
+
+		// ShortPRF is TurboSHAKE128 of the key and message.
+		h := NewTurboShake128(dsbyteShortPRF)
+		h.Write(key[:])
+		h.Write(msg)
+		want := make([]byte, ShortPRFSize)
+		h.Read(want)
+		if !bytes.Equal(got[:], want) {
+			t.Errorf("ShortPRF(%d bytes) = %x, TurboSHAKE128 = %x", tc.n, got, want)
+		}
+	}
+}
+
+func TestShortPRFAllocations(t *testing.T) {
+	var key [ShortPRFKeySize]byte
+	msg := make([]byte, 64)
+	if n := testing.AllocsPerRun(10, func() { ShortPRF(&key, msg) }); n > 0 {
+		t.Errorf("ShortPRF allocated %v times", n)
+	}
+}
+
+func BenchmarkShortPRF_64(b *testing.B) {
+	var key [ShortPRFKeySize]byte
+	msg := make([]byte, 64)
+	b.SetBytes(int64(len(msg)))
+	for i := 0; i < b.N; i++ {
+		ShortPRF(&key, msg)
+	}
+}
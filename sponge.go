@@ -0,0 +1,51 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"errors"
+	"hash"
+)
+
+// New returns a sponge over Keccak-f[1600] with the given rate and
+// capacity in bytes, which must be positive and add up to 200, and domain
+// separation byte, which holds the domain separation bits followed by the
+// first bit of the padding and must be in the range 0x01-0x7F. Sum returns
+// outputLen bytes, and the output can be read to an arbitrary length.
+//
+// New is intended for research and for non-standard instances; for
+// example, New(136, 64, 0x01, 32) is Keccak-256 and New(168, 32, 0x1f, 32)
+// is SHAKE128. The generic security strength is half the capacity. The
+// state can only be marshaled if dsbyte is that of one of the standard
+// functions of this package.
+func New(rate, capacity int, dsbyte byte, outputLen int) (ShakeHash, error) {
+	if rate <= 0 || capacity <= 0 || rate+capacity != 200 {
+		return nil, errors.New("keccak: rate and capacity must be positive and add up to 200 bytes")
+	}
+	if outputLen <= 0 {
+		return nil, errors.New("keccak: invalid sponge output length")
+	}
+	if dsbyte < 0x01 || dsbyte > 0x7f {
+		return nil, errors.New("keccak: domain separation byte must be in the range 0x01-0x7F")
+	}
+	return newState(state{rate: rate, outputLen: outputLen, dsbyte: dsbyte}), nil
+}
+
+// NewWithDomain returns a hash.Hash with the parameters of Keccak and
+// SHA-3 for the given output length, a capacity of twice the output
+// length, and a custom domain separation byte, as used by some chains. For
+// example, NewWithDomain(32, 0x01) is Keccak-256 and NewWithDomain(32,
+// 0x06) is SHA3-256. It panics if outputLen is not between 1 and 99 or if
+// dsbyte is not in the range 0x01-0x7F.
+func NewWithDomain(outputLen int, dsbyte byte) hash.Hash {
+	if outputLen <= 0 || outputLen >= 100 {
+		panic("keccak: invalid output length")
+	}
+	h, err := New(200-2*outputLen, 2*outputLen, dsbyte, outputLen)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"testing"
+)
+
+func TestNewStandardInstances(t *testing.T) {
+	msg := ptn(300)
+	sha3 := New256()
+	sha3.Write(msg)
+	for _, tc := range []struct {
+		rate, capacity int
+		dsbyte         byte
+		outputLen      int
+		want           []byte
+	}{
+		{136, 64, dsbyteKeccak, 32, keccak256(msg)},
+		{72, 128, dsbyteKeccak, 64, keccak512(msg)},
+		{136, 64, dsbyteSHA3, 32, sha3.Sum(nil)},
+	} {
+		h, err := New(tc.rate, tc.capacity, tc.dsbyte, tc.outputLen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.Write(msg)
+		if got := h.Sum(nil); !bytes.Equal(got, tc.want) {
+			t.Errorf("New(%d, %d, %#x, %d) = %x, want %x", tc.rate, tc.capacity, tc.dsbyte, tc.outputLen, got, tc.want)
+		}
+	}
+
+	h, _ := New(168, 32, dsbyteShake, 32)
+	got := make([]byte, 500)
+	h.Read(got)
+	want := make([]byte, 500)
+	ShakeSum128(want, nil)
+	if !bytes.Equal(got, want) {
+		t.Error("New(168, 32, 0x1f, 32) differs from SHAKE128")
+	}
+}
+
+func TestNewCustom(t *testing.T) {
+	// Output generated by _gen/sponge.py.
+	h, err := New(100, 100, 0x02, 48)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Write([]byte("abc"))
+	if got, want := hex.EncodeToString(h.Sum(nil)), "32c17bf6f15b965b9ef95d290513e6a77ba6a0894134eeb667d0d928088f26b0d78f5fd5d32ed372d217b98a22fde26d"; got != want {
+		t.Errorf("New(100, 100, 0x02, 48) = %s, want %s", got, want)
+	}
+	if _, err := h.(encoding.BinaryMarshaler).MarshalBinary(); err == nil {
+		t.Error("marshaled a sponge with a custom domain separation byte")
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	for _, tc := range []struct {
+		rate, capacity int
+		dsbyte         byte
+		outputLen      int
+	}{
+		{0, 200, 0x01, 32},
+		{200, 0, 0x01, 32},
+		{136, 32, 0x01, 32},
+		{136, 64, 0x00, 32},
+		{136, 64, 0x80, 32},
+		{136, 64, 0x01, 0},
+	} {
+		if _, err := New(tc.rate, tc.capacity, tc.dsbyte, tc.outputLen); err == nil {
+			t.Errorf("New(%d, %d, %#x, %d) succeeded", tc.rate, tc.capacity, tc.dsbyte, tc.outputLen)
+		}
+	}
+}
+
+func TestNewWithDomain(t *testing.T) {
+	msg := []byte("abc")
+	for _, tc := range []struct {
+		outputLen int
+		dsbyte    byte
+		ref       func() []byte
+	}{
+		{32, dsbyteKeccak, func() []byte { return keccak256(msg) }},
+		{64, dsbyteKeccak, func() []byte { return keccak512(msg) }},
+		{28, dsbyteSHA3, func() []byte { h := New224(); h.Write(msg); return h.Sum(nil) }},
+		{48, dsbyteSHA3, func() []byte { h := New384(); h.Write(msg); return h.Sum(nil) }},
+	} {
+		h := NewWithDomain(tc.outputLen, tc.dsbyte)
+		h.Write(msg)
+		if got, want := h.Sum(nil), tc.ref(); !bytes.Equal(got, want) {
+			t.Errorf("NewWithDomain(%d, %#x) = %x, want %x", tc.outputLen, tc.dsbyte, got, want)
+		}
+	}
+
+	for _, outputLen := range []int{0, 100} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewWithDomain(%d, 0x01) did not panic", outputLen)
+				}
+			}()
+			NewWithDomain(outputLen, 0x01)
+		}()
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides SpongePRG, the reseedable pseudorandom generator of
+// "Sponge-based pseudo-random number generators" [1], over the duplex
+// construction.
+//
+// [1] https://keccak.team/files/SpongePRNG.pdf
+
+import "encoding/binary"
+
+const (
+	// spongePRGRate leaves a capacity of 256 bits, for a security
+	// strength of 128 bits.
+	spongePRGRate = rateK256
+
+	// spongePRGBlockSize is the number of bytes absorbed and returned by
+	// each duplexing call.
+	spongePRGBlockSize = spongePRGRate - 1
+)
+
+// SpongePRG is a deterministic pseudorandom generator based on the duplex
+// construction. Its output is a function of the sequence of seeds and of
+// the amounts of output fetched between them, so the same calls always
+// produce the same bytes.
+//
+// SpongePRG implements io.Reader and the Source interface of math/rand/v2.
+// It is not safe for concurrent use.
+type SpongePRG struct {
+	d *Duplex
+
+	// in holds the part of the seed not yet absorbed, and fed is set when
+	// seed material was added since the last output.
+	in  []byte
+	fed bool
+
+	// out holds the output of the last duplexing call not yet returned.
+	buf [spongePRGBlockSize]byte
+	out []byte
+}
+
+// NewSpongePRG returns a generator seeded with seed.
+func NewSpongePRG(seed []byte) *SpongePRG {
+	p := &SpongePRG{d: NewDuplex(spongePRGRate)}
+	p.in = make([]byte, 0, spongePRGBlockSize)
+	p.Reseed(seed)
+	return p
+}
+
+// Reseed adds seed to the state of the generator. The output that follows
+// depends on all the seeds and output so far. Any output buffered from a
+// previous duplexing call is discarded.
+func (p *SpongePRG) Reseed(seed []byte) {
+	p.out = nil
+	p.fed = true
+	for len(seed) > 0 {
+		n := min(len(seed), spongePRGBlockSize-len(p.in))
+		p.in = append(p.in, seed[:n]...)
+		seed = seed[n:]
+		if len(p.in) == spongePRGBlockSize {
+			p.d.duplexing(p.in, nil, dsbyteKeccak)
+			p.in = p.in[:0]
+		}
+	}
+}
+
+// Read fills b with pseudorandom bytes. It always returns len(b), nil.
+func (p *SpongePRG) Read(b []byte) (n int, err error) {
+	n = len(b)
+	if p.fed {
+		// The last, possibly empty, block of seed is absorbed by the
+		// first duplexing call that produces output.
+		p.d.duplexing(p.in, p.buf[:], dsbyteKeccak)
+		p.in = p.in[:0]
+		p.out = p.buf[:]
+		p.fed = false
+	}
+	for len(b) > 0 {
+		if len(p.out) == 0 {
+			p.d.duplexing(nil, p.buf[:], dsbyteKeccak)
+			p.out = p.buf[:]
+		}
+		x := copy(b, p.out)
+		b = b[x:]
+		p.out = p.out[x:]
+	}
+	return n, nil
+}
+
+// Uint64 returns the next eight bytes of output as a little-endian
+// integer.
+func (p *SpongePRG) Uint64() uint64 {
+	var b [8]byte
+	p.Read(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"encoding/hex"
+	"io"
+	"math/rand/v2"
+	"testing"
+)
+
+var (
+	_ io.Reader   = (*SpongePRG)(nil)
+	_ rand.Source = (*SpongePRG)(nil)
+)
+
+// Outputs generated by _gen/spongeprg.py, an independent implementation of
+// SpongePRG.
+func TestSpongePRG(t *testing.T) {
+	p := NewSpongePRG([]byte("seed"))
+
+	out := make([]byte, 16)
+	p.Read(out)
+	if got, want := hex.EncodeToString(out), "3b3467917aaeb0c32098f92b3e3e5097"; got != want {
+		t.Errorf("first output = %s, want %s", got, want)
+	}
+
+	// Output spanning several duplexing calls.
+	out = make([]byte, 400)
+	p.Read(out)
+	if got, want := hex.EncodeToString(out[384:]), "b2e2709a9ef227ab85e5636e3990e8e4"; got != want {
+		t.Errorf("end of second output = %s, want %s", got, want)
+	}
+
+	p.Reseed(ptn(200))
+	out = make([]byte, 8)
+	p.Read(out)
+	if got, want := hex.EncodeToString(out), "c713430c992464f1"; got != want {
+		t.Errorf("output after reseeding = %s, want %s", got, want)
+	}
+	if got, want := p.Uint64(), uint64(1989394137808431549); got != want {
+		t.Errorf("Uint64 = %d, want %d", got, want)
+	}
+}
+
+func TestSpongePRGSplitReads(t *testing.T) {
+	// The output does not depend on how it is split into reads, nor on
+	// how the seed is split into calls to Reseed.
+	a := NewSpongePRG(ptn(500))
+	want := make([]byte, 1000)
+	a.Read(want)
+
+	b := NewSpongePRG(ptn(500)[:100])
+	b.Reseed(ptn(500)[100:])
+	got := make([]byte, 0, 1000)
+	for _, n := range []int{1, 166, 167, 168, 498} {
+		buf := make([]byte, n)
+		b.Read(buf)
+		got = append(got, buf...)
+	}
+	if hex.EncodeToString(got) != hex.EncodeToString(want) {
+		t.Error("split reads or seeds changed the output")
+	}
+}
+
+func TestSpongePRGRand(t *testing.T) {
+	r := rand.New(NewSpongePRG([]byte("fixture")))
+	s := rand.New(NewSpongePRG([]byte("fixture")))
+	for range 100 {
+		if r.IntN(1000) != s.IntN(1000) {
+			t.Fatal("generators with the same seed diverged")
+		}
+	}
+}
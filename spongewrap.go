@@ -0,0 +1,168 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides an authenticated encryption mode built on the duplex
+// construction, following SpongeWrap from "Duplexing the sponge" [1],
+// Algorithm 3.
+//
+// [1] https://keccak.team/files/SpongeDuplex.pdf
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+)
+
+const (
+	// spongeWrapRate is the rate of the underlying duplex object. It leaves
+	// a capacity of 256 bits, for a generic security strength of 128 bits.
+	spongeWrapRate = rateK256
+
+	// spongeWrapBlockSize is the block size ρ: each duplexing call absorbs
+	// one block and a frame bit, and produces one block of keystream.
+	spongeWrapBlockSize = spongeWrapRate - 1
+
+	spongeWrapKeySize   = 16
+	spongeWrapNonceSize = 16
+	spongeWrapMinTag    = 12
+	spongeWrapMaxTag    = 64
+)
+
+// Frame bits are appended to every block to separate the phases of the
+// mode. In the byte-oriented padding used here, the frame bit is the first
+// bit of dsbyte, followed by the first bit of the padding.
+const (
+	frameBit0 = 0b10
+	frameBit1 = 0b11
+)
+
+var errOpen = errors.New("keccak: message authentication failed")
+
+type spongeWrap struct {
+	key     []byte
+	tagSize int
+}
+
+// NewSpongeWrap returns a cipher.AEAD that performs SpongeWrap
+// authenticated encryption over Keccak-f[1600] with a 256-bit capacity.
+// The key must be at least 16 bytes long, and tagSize must be between 12
+// and 64 bytes; 16 is a good default.
+//
+// Every call to Seal initializes a new duplex object from the key and the
+// 16-byte nonce, so a nonce must never be reused with the same key.
+func NewSpongeWrap(key []byte, tagSize int) (cipher.AEAD, error) {
+	if len(key) < spongeWrapKeySize {
+		return nil, errors.New("keccak: SpongeWrap key must be at least 16 bytes")
+	}
+	if tagSize < spongeWrapMinTag || tagSize > spongeWrapMaxTag {
+		return nil, errors.New("keccak: invalid SpongeWrap tag size")
+	}
+	return &spongeWrap{key: append([]byte(nil), key...), tagSize: tagSize}, nil
+}
+
+func (w *spongeWrap) NonceSize() int { return spongeWrapNonceSize }
+
+func (w *spongeWrap) Overhead() int { return w.tagSize }
+
+// absorbFramed absorbs data in blocks, with the frame bit more on every
+// block but the last and the frame bit last on the last one. Empty data is
+// absorbed as a single empty block. The keystream following the last block
+// is written to z.
+func absorbFramed(d *Duplex, data []byte, more, last byte, z []byte) {
+	for len(data) > spongeWrapBlockSize {
+		d.duplexing(data[:spongeWrapBlockSize], nil, more)
+		data = data[spongeWrapBlockSize:]
+	}
+	d.duplexing(data, z, last)
+}
+
+// init returns a duplex object that has absorbed the key and nonce, and
+// the associated data, and writes the keystream for the first block of the
+// message to z.
+func (w *spongeWrap) init(nonce, additionalData, z []byte) *Duplex {
+	d := NewDuplex(spongeWrapRate)
+	keyNonce := make([]byte, 0, len(w.key)+len(nonce))
+	keyNonce = append(keyNonce, w.key...)
+	keyNonce = append(keyNonce, nonce...)
+	absorbFramed(d, keyNonce, frameBit1, frameBit0, nil)
+	absorbFramed(d, additionalData, frameBit0, frameBit1, z)
+	return d
+}
+
+// crypt processes the message blocks, XORing in into out with the
+// keystream in z and absorbing the plaintext, which is in when encrypting
+// and out when decrypting. It then writes the tag to tag.
+func (w *spongeWrap) crypt(d *Duplex, out, in, z, tag []byte, decrypt bool) {
+	// When encrypting in place, each plaintext block is overwritten before
+	// it is absorbed, so it is saved first.
+	var block [spongeWrapBlockSize]byte
+	for {
+		n := min(len(in), spongeWrapBlockSize)
+		plaintext := out[:n]
+		if !decrypt {
+			plaintext = block[:n]
+			copy(plaintext, in[:n])
+		}
+		subtle.XORBytes(out[:n], in[:n], z[:n])
+		in, out = in[n:], out[n:]
+		if len(in) == 0 {
+			d.duplexing(plaintext, z, frameBit0)
+			break
+		}
+		d.duplexing(plaintext, z[:min(len(in), spongeWrapBlockSize)], frameBit1)
+	}
+
+	for {
+		tag = tag[copy(tag, z):]
+		if len(tag) == 0 {
+			return
+		}
+		d.duplexing(nil, z, frameBit0)
+	}
+}
+
+func (w *spongeWrap) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	if len(nonce) != spongeWrapNonceSize {
+		panic("keccak: incorrect nonce length given to SpongeWrap")
+	}
+
+	ret, out := sliceForAppend(dst, len(plaintext)+w.tagSize)
+	if inexactOverlap(out, plaintext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	z := make([]byte, spongeWrapBlockSize)
+	d := w.init(nonce, additionalData, z[:min(len(plaintext), spongeWrapBlockSize)])
+	w.crypt(d, out[:len(plaintext)], plaintext, z, out[len(plaintext):], false)
+	return ret
+}
+
+func (w *spongeWrap) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != spongeWrapNonceSize {
+		panic("keccak: incorrect nonce length given to SpongeWrap")
+	}
+	if len(ciphertext) < w.tagSize {
+		return nil, errOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-w.tagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-w.tagSize]
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if inexactOverlap(out, ciphertext) {
+		panic("keccak: invalid buffer overlap")
+	}
+
+	z := make([]byte, spongeWrapBlockSize)
+	d := w.init(nonce, additionalData, z[:min(len(ciphertext), spongeWrapBlockSize)])
+	expected := make([]byte, w.tagSize)
+	w.crypt(d, out, ciphertext, z, expected, true)
+
+	if subtle.ConstantTimeCompare(expected, tag) != 1 {
+		clear(out)
+		return nil, errOpen
+	}
+	return ret, nil
+}
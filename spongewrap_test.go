@@ -0,0 +1,126 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Outputs generated by _gen/spongewrap.py, an independent implementation of
+// SpongeWrap. Long outputs are given as their Keccak-256 digest.
+var spongeWrapVectors = []struct {
+	adLen, msgLen, tagSize int
+	want                   string
+}{
+	{0, 0, 16, "0b74dfc5d14db76e7e13f2066365ef21"},
+	{3, 5, 16, "03384b0765a465ba3391d4091c42c35d538c972fc8"},
+	{200, 500, 32, "5dbdcfe1f946b4f7519c8ad5644a5bc80b0814d526c608b69f11fcfc9e20cf3e"},
+	{167, 334, 64, "30f3552c7662bde89c99e3e1752cb2cc18ea7b1d77ded2d90c45ec647ad34d3b"},
+	{0, 168, 12, "9644b99f056126b407deb233303255f8bd938eb213e61e0cf595779d1997270d"},
+}
+
+func spongeWrapTestKey() (key, nonce []byte) {
+	key = make([]byte, 16)
+	nonce = make([]byte, 16)
+	for i := range key {
+		key[i] = byte(i)
+		nonce[i] = byte(16 + i)
+	}
+	return key, nonce
+}
+
+func TestSpongeWrapVectors(t *testing.T) {
+	key, nonce := spongeWrapTestKey()
+	for _, v := range spongeWrapVectors {
+		aead, err := NewSpongeWrap(key, v.tagSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ad, msg := ptn(v.adLen), ptn(v.msgLen)
+		ct := aead.Seal(nil, nonce, msg, ad)
+		if len(ct) != len(msg)+aead.Overhead() {
+			t.Fatalf("ad=%d msg=%d: len = %d", v.adLen, v.msgLen, len(ct))
+		}
+		got := ct
+		if len(ct) > 24 {
+			sum := Sum256(ct)
+			got = sum[:]
+		}
+		if hex.EncodeToString(got) != v.want {
+			t.Errorf("ad=%d msg=%d tag=%d: got %x, want %s", v.adLen, v.msgLen, v.tagSize, got, v.want)
+		}
+
+		pt, err := aead.Open(nil, nonce, ct, ad)
+		if err != nil {
+			t.Fatalf("ad=%d msg=%d: Open: %v", v.adLen, v.msgLen, err)
+		}
+		if !bytes.Equal(pt, msg) {
+			t.Errorf("ad=%d msg=%d: Open returned the wrong plaintext", v.adLen, v.msgLen)
+		}
+	}
+}
+
+func TestSpongeWrapTamper(t *testing.T) {
+	key, nonce := spongeWrapTestKey()
+	aead, err := NewSpongeWrap(key, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ad, msg := []byte("header"), ptn(400)
+	ct := aead.Seal(nil, nonce, msg, ad)
+
+	for i := range ct {
+		bad := bytes.Clone(ct)
+		bad[i] ^= 0x01
+		if _, err := aead.Open(nil, nonce, bad, ad); err == nil {
+			t.Fatalf("Open accepted a ciphertext modified at byte %d", i)
+		}
+	}
+	if _, err := aead.Open(nil, nonce, ct, []byte("headex")); err == nil {
+		t.Error("Open accepted modified associated data")
+	}
+	badNonce := bytes.Clone(nonce)
+	badNonce[0] ^= 0x80
+	if _, err := aead.Open(nil, badNonce, ct, ad); err == nil {
+		t.Error("Open accepted the wrong nonce")
+	}
+	if _, err := aead.Open(nil, nonce, ct[:15], ad); err == nil {
+		t.Error("Open accepted a truncated ciphertext")
+	}
+}
+
+func TestSpongeWrapInPlace(t *testing.T) {
+	key, nonce := spongeWrapTestKey()
+	aead, err := NewSpongeWrap(key, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := ptn(300)
+	want := aead.Seal(nil, nonce, msg, nil)
+
+	buf := make([]byte, len(msg), len(msg)+aead.Overhead())
+	copy(buf, msg)
+	ct := aead.Seal(buf[:0], nonce, buf, nil)
+	if !bytes.Equal(ct, want) {
+		t.Fatal("in-place Seal differs")
+	}
+	pt, err := aead.Open(ct[:0], nonce, ct, nil)
+	if err != nil || !bytes.Equal(pt, msg) {
+		t.Fatalf("in-place Open = %v", err)
+	}
+}
+
+func TestSpongeWrapParameters(t *testing.T) {
+	if _, err := NewSpongeWrap(make([]byte, 15), 16); err == nil {
+		t.Error("accepted a 15-byte key")
+	}
+	for _, tagSize := range []int{0, 11, 65} {
+		if _, err := NewSpongeWrap(make([]byte, 16), tagSize); err == nil {
+			t.Errorf("accepted tag size %d", tagSize)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the STROBE protocol framework, version 1.0.2 [1], over
+// Keccak-f[1600]. A STROBE object is a duplex whose operations, such as
+// absorbing associated data, keying, encrypting or producing a MAC, are all
+// bound to a running transcript of the protocol, so that two parties
+// performing the same sequence of operations stay in sync and any
+// divergence shows up as a MAC failure.
+//
+// [1] https://strobe.sourceforge.io/specs/
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+// STROBE operation flags, as defined in Section 6.2 of the specification.
+const (
+	strobeFlagI = 1 << 0 // inbound: the data comes from the transport
+	strobeFlagA = 1 << 1 // the data is exchanged with the application
+	strobeFlagC = 1 << 2 // the data is mixed into the state as ciphertext
+	strobeFlagT = 1 << 3 // the data is exchanged with the transport
+	strobeFlagM = 1 << 4 // the operation is metadata
+	strobeFlagK = 1 << 5 // reserved for the keytree extension
+)
+
+// strobeRoleUndecided is the role of a STROBE object that has not yet sent
+// or received anything over the transport. Otherwise the role is the I flag
+// of its first transport operation.
+const strobeRoleUndecided = 2
+
+var errStrobeMAC = errors.New("keccak: STROBE MAC verification failed")
+
+// Strobe is a STROBE object. Both parties of a protocol create one with the
+// same protocol name and then perform matching operations: an AD on one side
+// matches an AD on the other, and a SendENC matches a RecvENC.
+//
+// Each operation takes a more argument. When more is true, the operation
+// continues the previous one, which must be of the same kind, as if their
+// data had been passed to a single call; this allows streaming large
+// inputs. For the operations producing output, the output of a call
+// depends only on the total length requested, not on how it is split.
+//
+// A Strobe may be forked with Clone.
+type Strobe struct {
+	// s holds the permutation state. Its buffer index is not used; pos
+	// indexes the outer part of the state instead.
+	s state
+
+	r        int  // the STROBE rate: the sponge rate less two padding bytes
+	pos      int  // the position in the outer part of the state
+	posBegin byte // the position where the current operation began, plus one
+	i0       byte // the role of the object, or strobeRoleUndecided
+	curFlags byte // the flags of the current operation
+}
+
+// NewStrobe128 returns a STROBE-128/1600 object initialized for the
+// protocol named proto. Its security strength is 128 bits.
+func NewStrobe128(proto []byte) *Strobe {
+	return newStrobe(proto, 128)
+}
+
+// NewStrobe256 returns a STROBE-256/1600 object initialized for the
+// protocol named proto. Its security strength is 256 bits.
+func NewStrobe256(proto []byte) *Strobe {
+	return newStrobe(proto, 256)
+}
+
+func newStrobe(proto []byte, security int) *Strobe {
+	s := &Strobe{r: 200 - security/4 - 2, i0: strobeRoleUndecided}
+	copy(s.s.a[:], []byte{1, byte(s.r + 2), 1, 0, 1, 12 * 8})
+	copy(s.s.a[6:], "STROBEv1.0.2")
+	s.s.permute()
+	s.operate(strobeFlagA|strobeFlagM, proto, false)
+	return s
+}
+
+// AD absorbs associated data, which both parties are assumed to know.
+func (s *Strobe) AD(data []byte, more bool) {
+	s.operate(strobeFlagA, data, more)
+}
+
+// MetaAD absorbs associated data describing the protocol itself, such as
+// framing or labels of the operations that follow.
+func (s *Strobe) MetaAD(data []byte, more bool) {
+	s.operate(strobeFlagA|strobeFlagM, data, more)
+}
+
+// Key replaces the start of the state with key, which both parties are
+// assumed to share.
+func (s *Strobe) Key(key []byte, more bool) {
+	// The operation overwrites its data, which belongs to the caller.
+	s.operate(strobeFlagA|strobeFlagC, append([]byte(nil), key...), more)
+}
+
+// PRF fills out with pseudorandom output bound to the transcript so far.
+func (s *Strobe) PRF(out []byte, more bool) {
+	clear(out)
+	s.operate(strobeFlagI|strobeFlagA|strobeFlagC, out, more)
+}
+
+// SendCLR absorbs data sent in the clear to the other party.
+func (s *Strobe) SendCLR(data []byte, more bool) {
+	s.operate(strobeFlagA|strobeFlagT, data, more)
+}
+
+// RecvCLR absorbs data received in the clear from the other party.
+func (s *Strobe) RecvCLR(data []byte, more bool) {
+	s.operate(strobeFlagI|strobeFlagA|strobeFlagT, data, more)
+}
+
+// SendENC encrypts data in place for sending to the other party. Encryption
+// is only confidential once the object has been keyed, and the ciphertext
+// should be followed by SendMAC to authenticate it.
+func (s *Strobe) SendENC(data []byte, more bool) {
+	s.operate(strobeFlagA|strobeFlagC|strobeFlagT, data, more)
+}
+
+// RecvENC decrypts data received from the other party in place. The
+// plaintext must not be trusted before a subsequent RecvMAC succeeds.
+func (s *Strobe) RecvENC(data []byte, more bool) {
+	s.operate(strobeFlagI|strobeFlagA|strobeFlagC|strobeFlagT, data, more)
+}
+
+// SendMAC fills out with a MAC of the transcript so far, for sending to the
+// other party.
+func (s *Strobe) SendMAC(out []byte, more bool) {
+	clear(out)
+	s.operate(strobeFlagC|strobeFlagT, out, more)
+}
+
+// RecvMAC checks a MAC received from the other party against the transcript
+// so far. If the MAC is wrong, the transcripts have diverged and the object
+// should no longer be used.
+func (s *Strobe) RecvMAC(mac []byte) error {
+	buf := make([]byte, len(mac))
+	copy(buf, mac)
+	s.operate(strobeFlagI|strobeFlagC|strobeFlagT, buf, false)
+	if subtle.ConstantTimeCompare(buf, make([]byte, len(buf))) != 1 {
+		return errStrobeMAC
+	}
+	return nil
+}
+
+// Ratchet zeroes length bytes of the state, so that a later compromise of
+// the object does not reveal earlier outputs.
+func (s *Strobe) Ratchet(length int, more bool) {
+	var zero [64]byte
+	for first := true; first || length > 0; first = false {
+		n := min(length, len(zero))
+		clear(zero[:n])
+		s.operate(strobeFlagC, zero[:n], more || !first)
+		length -= n
+	}
+}
+
+// Clone returns a copy of the object in its current state.
+func (s *Strobe) Clone() *Strobe {
+	ret := *s
+	return &ret
+}
+
+// operate performs the operation given by flags on data, which is
+// processed in place.
+func (s *Strobe) operate(flags byte, data []byte, more bool) {
+	if more {
+		if flags != s.curFlags {
+			panic("keccak: STROBE operation continued with different flags")
+		}
+	} else {
+		s.beginOp(flags)
+		s.curFlags = flags
+	}
+
+	// Outbound operations with the C flag return the state after mixing in
+	// the data, and all other C operations return it before.
+	cafter := flags&(strobeFlagC|strobeFlagI|strobeFlagT) == strobeFlagC|strobeFlagT
+	cbefore := flags&strobeFlagC != 0 && !cafter
+	s.duplex(data, cbefore, cafter, false)
+}
+
+// beginOp absorbs the position of the previous operation and the flags of
+// the new one, with the I flag of transport operations relative to the
+// role of the object.
+func (s *Strobe) beginOp(flags byte) {
+	if flags&strobeFlagT != 0 {
+		if s.i0 == strobeRoleUndecided {
+			s.i0 = flags & strobeFlagI
+		}
+		flags ^= s.i0
+	}
+	oldBegin := s.posBegin
+	s.posBegin = byte(s.pos + 1)
+	s.duplex([]byte{oldBegin, flags}, false, false, flags&(strobeFlagC|strobeFlagK) != 0)
+}
+
+// duplex absorbs data into the state byte by byte, replacing it in place
+// with the state before or after absorption if requested. If forceF is set,
+// a partial block is permuted at the end.
+func (s *Strobe) duplex(data []byte, cbefore, cafter, forceF bool) {
+	for i := range data {
+		if cbefore {
+			data[i] ^= s.s.a[s.pos]
+		}
+		s.s.a[s.pos] ^= data[i]
+		if cafter {
+			data[i] = s.s.a[s.pos]
+		}
+		s.pos++
+		if s.pos == s.r {
+			s.runF()
+		}
+	}
+	if forceF && s.pos != 0 {
+		s.runF()
+	}
+}
+
+// runF pads the current block, marking where the last operation began, and
+// applies the permutation.
+func (s *Strobe) runF() {
+	s.s.a[s.pos] ^= s.posBegin
+	s.s.a[s.pos+1] ^= 0x04
+	s.s.a[s.r+1] ^= 0x80
+	s.s.permute()
+	s.pos = 0
+	s.posBegin = 0
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+)
+
+// merlinAppend and merlinChallenge follow the Merlin transcript
+// construction, whose conformance vector exercises STROBE-128.
+func merlinAppend(s *Strobe, label, msg []byte) {
+	s.MetaAD(label, false)
+	s.MetaAD(binary.LittleEndian.AppendUint32(nil, uint32(len(msg))), true)
+	s.AD(msg, false)
+}
+
+func merlinChallenge(s *Strobe, label []byte, n int) []byte {
+	s.MetaAD(label, false)
+	s.MetaAD(binary.LittleEndian.AppendUint32(nil, uint32(n)), true)
+	out := make([]byte, n)
+	s.PRF(out, false)
+	return out
+}
+
+// Conformance test vector from the Merlin transcript library.
+func TestStrobeMerlin(t *testing.T) {
+	s := NewStrobe128([]byte("Merlin v1.0"))
+	merlinAppend(s, []byte("dom-sep"), []byte("test protocol"))
+	merlinAppend(s, []byte("some label"), []byte("some data"))
+	got := hex.EncodeToString(merlinChallenge(s, []byte("challenge"), 32))
+	want := "d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615"
+	if got != want {
+		t.Errorf("challenge = %s, want %s", got, want)
+	}
+}
+
+// Test vectors generated by _gen/strobe.py, a transcription of the STROBE
+// specification that also reproduces the Merlin vector above.
+func TestStrobeSession(t *testing.T) {
+	for _, tc := range []struct {
+		new                     func([]byte) *Strobe
+		prf, ct, mac, ct2, prf2 string
+	}{
+		{
+			NewStrobe128,
+			"78f6b7a23ffb8afd09d070c7588efc1e",
+			"efe73f4f3cd6f167fe9a02c827a2",
+			"8ee16569e12f714f5ec5f77a996581a9",
+			"ed271b59a144010a72288dddd6c69087c1a6682b2d831c4f727d9f3c2c35c350",
+			"62565b23bc8e5e347a8aec961c8509b03a7f56cbf3a2f71093a7fed694cd556c",
+		},
+		{
+			NewStrobe256,
+			"19b25f6dfe3d4e97d81c9496adb3cd18",
+			"341e87c28c8947a62387089e674c",
+			"afa2dde8aaf7247b6781e762ecb0dda2",
+			"6432fa1c0d50e612940de6089b94990b1c875dee6588b7f943883b7976223936",
+			"c1fca91797d89a89ea47c54439567352de0a095d25ee6bebd98e244fb8baf86e",
+		},
+	} {
+		a, b := tc.new([]byte("test")), tc.new([]byte("test"))
+		key := make([]byte, 32)
+		for i := range key {
+			key[i] = byte(i)
+		}
+		for _, s := range []*Strobe{a, b} {
+			s.AD([]byte("hello"), false)
+			s.AD([]byte(" world"), true)
+			s.Key(key, false)
+		}
+		if key[1] != 1 {
+			t.Fatal("Key modified its argument")
+		}
+
+		prf := make([]byte, 16)
+		a.PRF(prf, false)
+		b.PRF(make([]byte, 16), false)
+		if got := hex.EncodeToString(prf); got != tc.prf {
+			t.Errorf("PRF = %s, want %s", got, tc.prf)
+		}
+
+		msg := []byte("attack at dawn")
+		a.SendENC(msg, false)
+		if got := hex.EncodeToString(msg); got != tc.ct {
+			t.Errorf("SendENC = %s, want %s", got, tc.ct)
+		}
+		b.RecvENC(msg, false)
+		if string(msg) != "attack at dawn" {
+			t.Errorf("RecvENC = %q", msg)
+		}
+
+		mac := make([]byte, 16)
+		a.SendMAC(mac, false)
+		if got := hex.EncodeToString(mac); got != tc.mac {
+			t.Errorf("SendMAC = %s, want %s", got, tc.mac)
+		}
+		if err := b.RecvMAC(mac); err != nil {
+			t.Errorf("RecvMAC: %v", err)
+		}
+
+		a.Ratchet(32, false)
+		b.Ratchet(32, false)
+
+		// The roles swap: b sends and a receives.
+		clr := []byte("clear")
+		b.SendCLR(clr, false)
+		a.RecvCLR(clr, false)
+		ct2 := make([]byte, 300)
+		b.SendENC(ct2, false)
+		if got := hex.EncodeToString(ct2[:32]); got != tc.ct2 {
+			t.Errorf("second SendENC = %s, want %s", got, tc.ct2)
+		}
+		a.RecvENC(ct2, false)
+		if !bytes.Equal(ct2, make([]byte, 300)) {
+			t.Error("second RecvENC did not recover the plaintext")
+		}
+
+		prfA, prfB := make([]byte, 32), make([]byte, 32)
+		a.PRF(prfA, false)
+		b.PRF(prfB, false)
+		if got := hex.EncodeToString(prfA); got != tc.prf2 {
+			t.Errorf("final PRF = %s, want %s", got, tc.prf2)
+		}
+		if !bytes.Equal(prfA, prfB) {
+			t.Errorf("final PRF differs between the parties: %x and %x", prfA, prfB)
+		}
+	}
+}
+
+func TestStrobeRecvMACFailure(t *testing.T) {
+	a, b := NewStrobe128([]byte("test")), NewStrobe128([]byte("test"))
+	a.Key([]byte("key"), false)
+	b.Key([]byte("key"), false)
+	mac := make([]byte, 16)
+	a.SendMAC(mac, false)
+	mac[3] ^= 1
+	if err := b.RecvMAC(mac); err == nil {
+		t.Error("RecvMAC accepted a corrupted MAC")
+	}
+}
+
+// Splitting an operation with more gives the same result as a single call.
+func TestStrobeMore(t *testing.T) {
+	a, b := NewStrobe128([]byte("test")), NewStrobe128([]byte("test"))
+	data := ptn(500)
+	a.AD(data, false)
+	for i := 0; i < len(data); i += 37 {
+		b.AD(data[i:min(i+37, len(data))], i > 0)
+	}
+	a.Ratchet(200, false)
+	b.Ratchet(100, false)
+	b.Ratchet(100, true)
+	outA, outB := make([]byte, 400), make([]byte, 400)
+	a.PRF(outA, false)
+	b.PRF(outB[:150], false)
+	b.PRF(outB[150:], true)
+	if !bytes.Equal(outA, outB) {
+		t.Errorf("split operations = %x, want %x", outB, outA)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import "hash"
+
+// Template is the state of a hash after absorbing a fixed prefix, such as a
+// domain separation tag or the customization of cSHAKE. Hashes created from
+// it with NewFromTemplate start after the prefix, which is thus absorbed
+// once rather than for every message. A Template is never modified and may
+// be used by several goroutines at once.
+type Template struct {
+	s state
+}
+
+// NewTemplate returns a template holding the current state of h, which
+// must be a Keccak, SHA-3, SHAKE, cSHAKE or keyed Keccak hash of this
+// package from which no output has been read. NewTemplate panics otherwise.
+// h is not retained, and writing to it does not change the template.
+func NewTemplate(h hash.Hash) *Template {
+	var s *state
+	switch h := h.(type) {
+	case *state:
+		s = h
+	case *cshakeState:
+		s = h.state
+	case *keyedKeccak:
+		s = h.state
+	case *templateHash:
+		s = h.state
+	default:
+		panic("keccak: NewTemplate of an unsupported hash")
+	}
+	if s.state != spongeAbsorbing {
+		panic("keccak: NewTemplate after Read")
+	}
+	return &Template{s: *s}
+}
+
+// NewFromTemplate returns a new hash in the state of t, to which the rest
+// of the message is written. Its Reset method returns it to the state of t
+// rather than to the initial state, so a single hash can be reused for
+// many messages sharing the prefix.
+func NewFromTemplate(t *Template) ShakeHash {
+	return &templateHash{state: t.s.clone(), t: t}
+}
+
+// templateHash is a hash created from a template, which it restores on
+// Reset.
+type templateHash struct {
+	*state
+	t *Template
+}
+
+// Reset restores the state of the template, discarding any data written
+// after the prefix.
+func (h *templateHash) Reset() {
+	*h.state = h.t.s
+}
+
+// Clone returns a copy of the hash in its current state, which also resets
+// to the template.
+func (h *templateHash) Clone() ShakeHash {
+	return &templateHash{state: h.clone(), t: h.t}
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"hash"
+	"testing"
+)
+
+func TestTemplate(t *testing.T) {
+	prefix := ptn(300)
+	for _, tc := range []struct {
+		name string
+		new  func() hash.Hash
+	}{
+		{"Keccak256", NewLegacyKeccak256},
+		{"SHA3-512", New512},
+		{"SHAKE128", func() hash.Hash { return NewShake128() }},
+		{"cSHAKE256", func() hash.Hash { return NewCShake256([]byte("N"), []byte("custom")) }},
+		{"KeyedKeccak256", func() hash.Hash { return NewKeyedKeccak256(make([]byte, 32)) }},
+	} {
+		h := tc.new()
+		h.Write(prefix)
+		tmpl := NewTemplate(h)
+		// Writing to the hash the template was made from does not change it.
+		h.Write([]byte("ignored"))
+
+		th := NewFromTemplate(tmpl)
+		for _, n := range []int{0, 32, 200, 1000} {
+			msg := ptn(n)
+			want := tc.new()
+			want.Write(prefix)
+			want.Write(msg)
+			th.Reset()
+			th.Write(msg)
+			if got, want := th.Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+				t.Errorf("%s: template hash of %d bytes = %x, want %x", tc.name, n, got, want)
+			}
+
+			// A clone also resets to the template.
+			c := th.Clone()
+			c.Reset()
+			c.Write(msg)
+			if got, want := c.Sum(nil), th.Sum(nil); !bytes.Equal(got, want) {
+				t.Errorf("%s: cloned template hash of %d bytes = %x, want %x", tc.name, n, got, want)
+			}
+		}
+
+		// A template of a template hash continues from its state.
+		th.Reset()
+		th.Write([]byte("more"))
+		want := tc.new()
+		want.Write(prefix)
+		want.Write([]byte("more"))
+		if got, want := NewFromTemplate(NewTemplate(th)).Sum(nil), want.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%s: template of a template hash = %x, want %x", tc.name, got, want)
+		}
+	}
+}
+
+func TestTemplatePanics(t *testing.T) {
+	read := NewShake256()
+	read.Read(make([]byte, 1))
+	for _, tc := range []struct {
+		name string
+		h    hash.Hash
+	}{
+		{"KMAC", NewKMAC128(make([]byte, 16), nil, 32)},
+		{"read", read},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewTemplate of a %s hash did not panic", tc.name)
+				}
+			}()
+			NewTemplate(tc.h)
+		}()
+	}
+}
+
+func TestTemplateAllocs(t *testing.T) {
+	h := NewLegacyKeccak256()
+	h.Write([]byte("domain tag"))
+	th := NewFromTemplate(NewTemplate(h)).(*templateHash)
+	msg := ptn(64)
+	if n := testing.AllocsPerRun(10, func() {
+		th.Reset()
+		th.Write(msg)
+		th.Sum256()
+	}); n > 0 {
+		t.Errorf("reusing a template hash allocated %v times, want 0", n)
+	}
+}
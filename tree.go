@@ -0,0 +1,126 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"runtime"
+	"sync"
+)
+
+// parallelThreshold is the amount of input below which leaves are hashed on
+// the calling goroutine, as the cost of starting workers would dominate.
+const parallelThreshold = 32 * 1024
+
+// maxLeavesPerBatch bounds the number of chaining values buffered before
+// they are absorbed into the final node, to bound memory use on very large
+// writes.
+const maxLeavesPerBatch = 4096
+
+// treeLeaves splits a stream into fixed-size leaves, as used by the tree
+// hashing modes ParallelHash and KangarooTwelve. Each leaf is hashed with a
+// copy of a template sponge, and the resulting chaining values are absorbed
+// into the final node in order. Large writes have their leaves hashed
+// concurrently on up to GOMAXPROCS goroutines.
+type treeLeaves struct {
+	leaf  state  // template sponge for hashing a single leaf
+	size  int    // the leaf size, in bytes
+	cvLen int    // the length of each leaf's chaining value
+	buf   []byte // a partial leaf of at most size-1 bytes
+	count uint64 // the number of leaves absorbed into the final node
+}
+
+// write splits p into leaves, absorbing the chaining value of each complete
+// leaf into final.
+func (t *treeLeaves) write(final *state, p []byte) {
+	// Complete a buffered partial leaf first.
+	if len(t.buf) > 0 {
+		x := min(len(p), t.size-len(t.buf))
+		t.buf = append(t.buf, p[:x]...)
+		p = p[x:]
+		if len(t.buf) == t.size {
+			t.absorb(final, t.buf)
+			t.buf = t.buf[:0]
+		}
+	}
+
+	// Hash complete leaves straight from the input.
+	if full := len(p) / t.size * t.size; full > 0 {
+		t.absorb(final, p[:full])
+		p = p[full:]
+	}
+
+	t.buf = append(t.buf, p...)
+}
+
+// flush absorbs the chaining value of the trailing partial leaf, if any.
+func (t *treeLeaves) flush(final *state) {
+	if len(t.buf) > 0 {
+		cv := make([]byte, t.cvLen)
+		t.hash(t.buf, cv)
+		final.Write(cv)
+		t.count++
+		t.buf = t.buf[:0]
+	}
+}
+
+// absorb hashes data, which must be a whole number of leaves, and absorbs
+// the chaining values into final in order.
+func (t *treeLeaves) absorb(final *state, data []byte) {
+	batch := maxLeavesPerBatch * t.size
+	cvs := make([]byte, min(len(data)/t.size, maxLeavesPerBatch)*t.cvLen)
+	for len(data) > 0 {
+		chunk := data[:min(len(data), batch)]
+		data = data[len(chunk):]
+		count := len(chunk) / t.size
+		out := cvs[:count*t.cvLen]
+
+		workers := min(runtime.GOMAXPROCS(0), count)
+		if len(chunk) < parallelThreshold || workers < 2 {
+			t.hashRange(chunk, out)
+		} else {
+			var wg sync.WaitGroup
+			per := lineRun((count+workers-1)/workers, t.cvLen)
+			for lo := 0; lo < count; lo += per {
+				hi := min(lo+per, count)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					t.hashRange(chunk[lo*t.size:hi*t.size], out[lo*t.cvLen:hi*t.cvLen])
+				}()
+			}
+			wg.Wait()
+		}
+
+		final.Write(out)
+		t.count += uint64(count)
+	}
+}
+
+// hashRange writes the chaining value of each leaf of data into cvs.
+func (t *treeLeaves) hashRange(data, cvs []byte) {
+	for len(data) > 0 {
+		t.hash(data[:t.size], cvs[:t.cvLen])
+		data, cvs = data[t.size:], cvs[t.cvLen:]
+	}
+}
+
+// hash writes the chaining value of a single leaf into cv.
+func (t *treeLeaves) hash(leaf, cv []byte) {
+	d := t.leaf
+	d.Write(leaf)
+	d.Read(cv)
+}
+
+// reset discards any buffered input and the leaf count.
+func (t *treeLeaves) reset() {
+	t.buf = t.buf[:0]
+	t.count = 0
+}
+
+func (t *treeLeaves) clone() treeLeaves {
+	ret := *t
+	ret.buf = append([]byte(nil), t.buf...)
+	return ret
+}
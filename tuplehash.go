@@ -0,0 +1,129 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the TupleHash128 and TupleHash256 functions and their
+// TupleHashXOF variants, as specified in NIST SP 800-185 [1].
+//
+// [1] https://doi.org/10.6028/NIST.SP.800-185
+
+// TupleHash hashes a sequence of byte strings such that the boundaries
+// between them are part of the input: ("ab", "c") and ("a", "bc") produce
+// unrelated digests.
+//
+// A TupleHash does not implement io.Writer, because every call to
+// WriteElement starts a new element of the tuple.
+type TupleHash struct {
+	d *state
+
+	// initBlock is bytepad(encode_string("TupleHash") || encode_string(S)),
+	// absorbed on construction and on every Reset.
+	initBlock []byte
+
+	// xof is set for the TupleHashXOF variants, which encode an output
+	// length of zero so that the output can be read to an arbitrary length.
+	xof bool
+}
+
+func newTupleHash(S []byte, rate, outputLen int, xof bool) *TupleHash {
+	var prefix []byte
+	prefix = appendEncodeString(prefix, []byte("TupleHash"))
+	prefix = appendEncodeString(prefix, S)
+	t := &TupleHash{
+		d:         newState(state{rate: rate, outputLen: outputLen, dsbyte: dsbyteCShake}),
+		initBlock: bytepad(prefix, rate),
+		xof:       xof,
+	}
+	t.d.Write(t.initBlock)
+	return t
+}
+
+// NewTupleHash128 returns a new TupleHash128 customized by S, producing
+// digests of outputLen bytes.
+func NewTupleHash128(S []byte, outputLen int) *TupleHash {
+	if outputLen <= 0 {
+		panic("keccak: invalid TupleHash output length")
+	}
+	return newTupleHash(S, rateK256, outputLen, false)
+}
+
+// NewTupleHash256 returns a new TupleHash256 customized by S, producing
+// digests of outputLen bytes.
+func NewTupleHash256(S []byte, outputLen int) *TupleHash {
+	if outputLen <= 0 {
+		panic("keccak: invalid TupleHash output length")
+	}
+	return newTupleHash(S, rateK512, outputLen, false)
+}
+
+// NewTupleHashXOF128 returns a new TupleHashXOF128 customized by S. Its
+// output can be read to an arbitrary length; Sum returns the first 32 bytes.
+func NewTupleHashXOF128(S []byte) *TupleHash {
+	return newTupleHash(S, rateK256, 32, true)
+}
+
+// NewTupleHashXOF256 returns a new TupleHashXOF256 customized by S. Its
+// output can be read to an arbitrary length; Sum returns the first 64 bytes.
+func NewTupleHashXOF256(S []byte) *TupleHash {
+	return newTupleHash(S, rateK512, 64, true)
+}
+
+// WriteElement appends x to the tuple being hashed. It panics if any output
+// has already been read.
+func (t *TupleHash) WriteElement(x []byte) {
+	t.d.Write(leftEncode(uint64(len(x)) * 8))
+	t.d.Write(x)
+}
+
+// BlockSize returns the rate of the underlying sponge.
+func (t *TupleHash) BlockSize() int { return t.d.rate }
+
+// Size returns the number of bytes Sum will append.
+func (t *TupleHash) Size() int { return t.d.outputLen }
+
+// Reset discards all elements written so far.
+func (t *TupleHash) Reset() {
+	t.d.Reset()
+	t.d.Write(t.initBlock)
+}
+
+// finalizeTupleHash absorbs the encoded output length that terminates the
+// tuple.
+func finalizeTupleHash(d *state, xof bool) {
+	if xof {
+		d.Write(rightEncode(0))
+	} else {
+		d.Write(rightEncode(uint64(d.outputLen) * 8))
+	}
+}
+
+// Sum appends the digest of the tuple written so far to in. It does not
+// change the underlying state, and panics if any output has already been
+// read.
+func (t *TupleHash) Sum(in []byte) []byte {
+	if t.d.state != spongeAbsorbing {
+		panic("keccak: Sum after Read")
+	}
+
+	dup := t.d.clone()
+	finalizeTupleHash(dup, t.xof)
+	hash := make([]byte, dup.outputLen)
+	_, _ = dup.Read(hash)
+	return append(in, hash...)
+}
+
+// Read squeezes output from the hash. The first call finalizes the tuple,
+// and subsequent calls to WriteElement or Sum will panic.
+func (t *TupleHash) Read(out []byte) (n int, err error) {
+	if t.d.state == spongeAbsorbing {
+		finalizeTupleHash(t.d, t.xof)
+	}
+	return t.d.Read(out)
+}
+
+// Clone returns a copy of the TupleHash in its current state.
+func (t *TupleHash) Clone() *TupleHash {
+	return &TupleHash{d: t.d.clone(), initBlock: t.initBlock, xof: t.xof}
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Samples from NIST's TupleHash_samples.pdf and TupleHashXOF_samples.pdf.
+func TestTupleHashSamples(t *testing.T) {
+	x1 := []byte{0x00, 0x01, 0x02}
+	x2 := []byte{0x10, 0x11, 0x12, 0x13, 0x14, 0x15}
+	x3 := []byte{0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28}
+	app := []byte("My Tuple App")
+	for _, tc := range []struct {
+		name  string
+		h     *TupleHash
+		tuple [][]byte
+		want  string
+	}{
+		{"TupleHash128 #1", NewTupleHash128(nil, 32), [][]byte{x1, x2}, "c5d8786c1afb9b82111ab34b65b2c0048fa64e6d48e263264ce1707d3ffc8ed1"},
+		{"TupleHash128 #2", NewTupleHash128(app, 32), [][]byte{x1, x2}, "75cdb20ff4db1154e841d758e24160c54bae86eb8c13e7f5f40eb35588e96dfb"},
+		{"TupleHash128 #3", NewTupleHash128(app, 32), [][]byte{x1, x2, x3}, "e60f202c89a2631eda8d4c588ca5fd07f39e5151998deccf973adb3804bb6e84"},
+		{"TupleHash256 #4", NewTupleHash256(nil, 64), [][]byte{x1, x2}, "cfb7058caca5e668f81a12a20a2195ce97a925f1dba3e7449a56f82201ec607311ac2696b1ab5ea2352df1423bde7bd4bb78c9aed1a853c78672f9eb23bbe194"},
+		{"TupleHash256 #5", NewTupleHash256(app, 64), [][]byte{x1, x2}, "147c2191d5ed7efd98dbd96d7ab5a11692576f5fe2a5065f3e33de6bba9f3aa1c4e9a068a289c61c95aab30aee1e410b0b607de3620e24a4e3bf9852a1d4367e"},
+		{"TupleHash256 #6", NewTupleHash256(app, 64), [][]byte{x1, x2, x3}, "45000be63f9b6bfd89f54717670f69a9bc763591a4f05c50d68891a744bcc6e7d6d5b5e82c018da999ed35b0bb49c9678e526abd8e85c13ed254021db9e790ce"},
+		{"TupleHashXOF128 #1", NewTupleHashXOF128(nil), [][]byte{x1, x2}, "2f103cd7c32320353495c68de1a8129245c6325f6f2a3d608d92179c96e68488"},
+		{"TupleHashXOF128 #2", NewTupleHashXOF128(app), [][]byte{x1, x2}, "3fc8ad69453128292859a18b6c67d7ad85f01b32815e22ce839c49ec374e9b9a"},
+		{"TupleHashXOF128 #3", NewTupleHashXOF128(app), [][]byte{x1, x2, x3}, "900fe16cad098d28e74d632ed852f99daab7f7df4d99e775657885b4bf76d6f8"},
+		{"TupleHashXOF256 #4", NewTupleHashXOF256(nil), [][]byte{x1, x2}, "03ded4610ed6450a1e3f8bc44951d14fbc384ab0efe57b000df6b6df5aae7cd568e77377daf13f37ec75cf5fc598b6841d51dd207c991cd45d210ba60ac52eb9"},
+		{"TupleHashXOF256 #5", NewTupleHashXOF256(app), [][]byte{x1, x2}, "6483cb3c9952eb20e830af4785851fc597ee3bf93bb7602c0ef6a65d741aeca7e63c3b128981aa05c6d27438c79d2754bb1b7191f125d6620fca12ce658b2442"},
+		{"TupleHashXOF256 #6", NewTupleHashXOF256(app), [][]byte{x1, x2, x3}, "0c59b11464f2336c34663ed51b2b950bec743610856f36c28d1d088d8a2446284dd09830a6a178dc752376199fae935d86cfdee5913d4922dfd369b66a53c897"},
+	} {
+		for _, x := range tc.tuple {
+			tc.h.WriteElement(x)
+		}
+		if got := hex.EncodeToString(tc.h.Sum(nil)); got != tc.want {
+			t.Errorf("%s: Sum = %s, want %s", tc.name, got, tc.want)
+		}
+		out := make([]byte, tc.h.Size())
+		tc.h.Read(out)
+		if got := hex.EncodeToString(out); got != tc.want {
+			t.Errorf("%s: Read = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTupleHashBoundaries(t *testing.T) {
+	h1 := NewTupleHash128(nil, 32)
+	h1.WriteElement([]byte("ab"))
+	h1.WriteElement([]byte("c"))
+	h2 := NewTupleHash128(nil, 32)
+	h2.WriteElement([]byte("a"))
+	h2.WriteElement([]byte("bc"))
+	if bytes.Equal(h1.Sum(nil), h2.Sum(nil)) {
+		t.Error("TupleHash ignored element boundaries")
+	}
+
+	// An empty tuple and a tuple holding one empty string also differ.
+	h3 := NewTupleHash128(nil, 32)
+	h4 := NewTupleHash128(nil, 32)
+	h4.WriteElement(nil)
+	if bytes.Equal(h3.Sum(nil), h4.Sum(nil)) {
+		t.Error("TupleHash confused () with (\"\")")
+	}
+}
+
+func TestTupleHashResetAndClone(t *testing.T) {
+	h := NewTupleHashXOF256([]byte("S"))
+	h.WriteElement([]byte("element"))
+	want := make([]byte, 200)
+	h.Clone().Read(want)
+
+	h.WriteElement([]byte("garbage"))
+	h.Reset()
+	h.WriteElement([]byte("element"))
+	got := make([]byte, 200)
+	h.Read(got)
+	if !bytes.Equal(got, want) {
+		t.Errorf("after Reset, TupleHashXOF256 = %x, want %x", got, want)
+	}
+}
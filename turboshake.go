@@ -0,0 +1,38 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+// This file provides the TurboSHAKE128 and TurboSHAKE256 extendable-output
+// functions, as specified in RFC 9861 [1]. TurboSHAKE is SHAKE with the
+// permutation reduced to 12 rounds and a caller-chosen domain separation
+// byte; KangarooTwelve and KT256 are built on it.
+//
+// [1] https://www.rfc-editor.org/rfc/rfc9861
+
+// checkTurboShakeDomain panics if D is not a valid TurboSHAKE domain
+// separation byte.
+func checkTurboShakeDomain(D byte) {
+	if D < 0x01 || D > 0x7f {
+		panic("keccak: TurboSHAKE domain separation byte must be in the range 0x01-0x7F")
+	}
+}
+
+// NewTurboShake128 creates a new TurboSHAKE128 ShakeHash with the domain
+// separation byte D, which must be in the range 0x01-0x7F. Its generic
+// security strength is 128 bits if at least 32 bytes of its output are
+// used. Absent another convention, D should be 0x1F.
+func NewTurboShake128(D byte) ShakeHash {
+	checkTurboShakeDomain(D)
+	return newState(state{rate: rateK256, outputLen: 32, dsbyte: D, rounds: k12Rounds, turboShake: true})
+}
+
+// NewTurboShake256 creates a new TurboSHAKE256 ShakeHash with the domain
+// separation byte D, which must be in the range 0x01-0x7F. Its generic
+// security strength is 256 bits if at least 64 bytes of its output are
+// used. Absent another convention, D should be 0x1F.
+func NewTurboShake256(D byte) ShakeHash {
+	checkTurboShakeDomain(D)
+	return newState(state{rate: rateK512, outputLen: 64, dsbyte: D, rounds: k12Rounds, turboShake: true})
+}
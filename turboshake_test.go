@@ -0,0 +1,94 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from RFC 9861, Section 5.
+func TestTurboShakeVectors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		newFunc func(D byte) ShakeHash
+		msg     []byte
+		D       byte
+		want    string
+	}{
+		{"TurboSHAKE128", NewTurboShake128, nil, 0x1f, "1e415f1c5983aff2169217277d17bb538cd945a397ddec541f1ce41af2c1b74c"},
+		{"TurboSHAKE128", NewTurboShake128, ptn(1), 0x1f, "55cedd6f60af7bb29a4042ae832ef3f58db7299f893ebb9247247d856958daa9"},
+		{"TurboSHAKE128", NewTurboShake128, ptn(17), 0x1f, "9c97d036a3bac819db70ede0ca554ec6e4c2a1a4ffbfd9ec269ca6a111161233"},
+		{"TurboSHAKE128", NewTurboShake128, ptn(17 * 17), 0x1f, "96c77c279e0126f7fc07c9b07f5cdae1e0be60bdbe10620040e75d7223a624d2"},
+		{"TurboSHAKE128", NewTurboShake128, []byte{0xff, 0xff, 0xff}, 0x01, "bf323f940494e88ee1c540fe660be8a0c93f43d15ec006998462fa994eed5dab"},
+		{"TurboSHAKE128", NewTurboShake128, []byte{0xff}, 0x06, "8ec9c66465ed0d4a6c35d13506718d687a25cb05c74cca1e42501abd83874a67"},
+		{"TurboSHAKE128", NewTurboShake128, []byte{0xff, 0xff, 0xff}, 0x07, "b658576001cad9b1e5f399a9f77723bba05458042d68206f7252682dba3663ed"},
+		{"TurboSHAKE256", NewTurboShake256, nil, 0x1f, "367a329dafea871c7802ec67f905ae13c57695dc2c6663c61035f59a18f8e7db11edc0e12e91ea60eb6b32df06dd7f002fbafabb6e13ec1cc20d995547600db0"},
+		{"TurboSHAKE256", NewTurboShake256, ptn(17), 0x1f, "b3bab0300e6a191fbe6137939835923578794ea54843f5011090fa2f3780a9e5cb22c59d78b40a0fbff9e672c0fbe0970bd2c845091c6044d687054da5d8e9c7"},
+		{"TurboSHAKE256", NewTurboShake256, []byte{0xff, 0xff, 0xff}, 0x01, "d21c6fbbf587fa2282f29aea620175fb0257413af78a0b1b2a87419ce031d933ae7a4d383327a8a17641a34f8a1d1003ad7da6b72dba84bb62fef28f62f12424"},
+		{"TurboSHAKE256", NewTurboShake256, []byte{0xff}, 0x06, "738d7b4e37d18b7f22ad1b5313e357e3dd7d07056a26a303c433fa3533455280f4f5a7d4f700efb437fe6d281405e07be32a0a972e22e63adc1b090daefe004b"},
+	} {
+		h := tc.newFunc(tc.D)
+		h.Write(tc.msg)
+		if got := hex.EncodeToString(h.Sum(nil)); got != tc.want {
+			t.Errorf("%s(%d bytes, D=%#02x) = %s, want %s", tc.name, len(tc.msg), tc.D, got, tc.want)
+		}
+	}
+}
+
+func TestTurboShakeLongOutput(t *testing.T) {
+	// RFC 9861: TurboSHAKE128(M=empty, D=0x1F, 10032), last 32 bytes.
+	want := "a3b9b0385900ce761f22aed548e754da10a5242d62e8c658e3f3a923a7555607"
+	out := make([]byte, 10032)
+	NewTurboShake128(0x1f).Read(out)
+	if got := hex.EncodeToString(out[len(out)-32:]); got != want {
+		t.Errorf("TurboSHAKE128 output bytes 10000-10031 = %s, want %s", got, want)
+	}
+}
+
+func TestTurboShakeInvalidDomain(t *testing.T) {
+	for _, D := range []byte{0x00, 0x80, 0xff} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewTurboShake128(%#02x) did not panic", D)
+				}
+			}()
+			NewTurboShake128(D)
+		}()
+	}
+}
+
+func TestTurboShakeMarshalUnmarshal(t *testing.T) {
+	h1 := NewTurboShake128(0x1f)
+	h1.Write([]byte("hello"))
+	data, err := h1.(*state).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewShake128().(*state).UnmarshalBinary(data); err == nil {
+		t.Error("SHAKE128 accepted a TurboSHAKE state")
+	}
+	if err := NewTurboShake128(0x07).(*state).UnmarshalBinary(data); err == nil {
+		t.Error("TurboSHAKE128 with D = 0x07 accepted a state with D = 0x1f")
+	}
+	if err := NewTurboShake256(0x1f).(*state).UnmarshalBinary(data); err == nil {
+		t.Error("TurboSHAKE256 accepted a TurboSHAKE128 state")
+	}
+	h2 := NewTurboShake128(0x1f)
+	if err := h2.(*state).UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	a := make([]byte, 64)
+	b := make([]byte, 64)
+	h1.Read(a)
+	h2.Read(b)
+	if !bytes.Equal(a, b) {
+		t.Error("marshaled/unmarshaled TurboSHAKE produced different output")
+	}
+}
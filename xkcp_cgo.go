@@ -0,0 +1,39 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package keccak
+
+// With the keccak_xkcp build tag, Keccak-f[1600] is run by the XKCP C
+// library on the platforms for which this package has no assembly, and in
+// purego and noasm builds. See the internal/xkcp package for how to build
+// XKCP.
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	"github.com/filecoin-project/go-keccak/internal/xkcp"
+)
+
+// useXKCP reports whether the permutation is run by XKCP where there is no
+// assembly.
+var useXKCP = backendAllowed("xkcp")
+
+// keccakF1600XKCP applies Keccak-f[1600] to a with XKCP.
+func keccakF1600XKCP(a *[25]uint64) {
+	if !isBigEndian {
+		xkcp.Permute((*[200]byte)(unsafe.Pointer(a)))
+		return
+	}
+	var b [200]byte
+	for i := range a {
+		binary.LittleEndian.PutUint64(b[i*8:], a[i])
+	}
+	xkcp.Permute(&b)
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(b[i*8:])
+	}
+}
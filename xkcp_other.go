@@ -0,0 +1,14 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !keccak_xkcp || !cgo
+
+package keccak
+
+// useXKCP reports whether the permutation is run by the XKCP C library.
+const useXKCP = false
+
+func keccakF1600XKCP(a *[25]uint64) {
+	panic("keccak: XKCP is not available")
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build keccak_xkcp && cgo
+
+package keccak
+
+import "testing"
+
+func TestKeccakF1600XKCPMatchesGeneric(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i+1) * 0x9e3779b97f4a7c15
+	}
+	b = a
+	for range 10 {
+		keccakF1600XKCP(&a)
+		keccakP1600(&b, 24)
+	}
+	if a != b {
+		t.Errorf("XKCP permutation disagrees with the generic one")
+	}
+	for i, want := range keccakF1600Vectors {
+		a = [25]uint64{}
+		for range i + 1 {
+			keccakF1600XKCP(&a)
+		}
+		if a != want {
+			t.Errorf("XKCP permutation applied %d times = %016X, want %016X", i+1, a, want)
+		}
+	}
+
+	// The big-endian path converts the lanes explicitly, so it can be
+	// exercised on any host.
+	defer func(b bool) { isBigEndian = b }(isBigEndian)
+	isBigEndian = true
+	a = [25]uint64{}
+	keccakF1600XKCP(&a)
+	if a != keccakF1600Vectors[0] {
+		t.Errorf("XKCP permutation through the big-endian path = %016X, want %016X", a, keccakF1600Vectors[0])
+	}
+}
+
+func BenchmarkKeccakF1600XKCP(b *testing.B) {
+	var a [25]uint64
+	b.SetBytes(200)
+	for i := 0; i < b.N; i++ {
+		keccakF1600XKCP(&a)
+	}
+}
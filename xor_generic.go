@@ -0,0 +1,15 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (!386 && !amd64 && !arm64 && !loong64 && !ppc64 && !ppc64le && !s390x) || purego || noasm
+
+package keccak
+
+import "crypto/subtle"
+
+// xorIn XORs src into dst and returns the number of bytes XORed, which is
+// the length of the shorter of the two.
+func xorIn(dst, src []byte) int {
+	return subtle.XORBytes(dst, dst, src)
+}
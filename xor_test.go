@@ -0,0 +1,30 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package keccak
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestXorIn(t *testing.T) {
+	src := ptn(200)
+	for off := 0; off < 8; off++ {
+		so := off * 5 % 8
+		for n := 0; n <= 150; n++ {
+			dst := bytes.Repeat([]byte{0x5a}, 160)
+			want := bytes.Clone(dst)
+			for i := range n {
+				want[off+i] ^= src[so+i]
+			}
+			if got := xorIn(dst[off:off+n], src[so:]); got != n {
+				t.Errorf("xorIn(%d bytes at offset %d) = %d, want %d", n, off, got, n)
+			}
+			if !bytes.Equal(dst, want) {
+				t.Errorf("xorIn(%d bytes at offset %d) wrote %x, want %x", n, off, dst, want)
+			}
+		}
+	}
+}
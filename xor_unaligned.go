@@ -0,0 +1,40 @@
+// Copyright 2024 The go-keccak Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (386 || amd64 || arm64 || loong64 || ppc64 || ppc64le || s390x) && !purego && !noasm
+
+package keccak
+
+import (
+	"crypto/subtle"
+	"unsafe"
+)
+
+// xorInWordsMax is the length from which xorIn leaves the work to
+// subtle.XORBytes, whose vector code is faster on long inputs once its
+// setup is paid for.
+const xorInWordsMax = 64
+
+// xorIn XORs src into dst and returns the number of bytes XORed, which is
+// the length of the shorter of the two.
+//
+// Short inputs, such as the 32-byte hashes and keys that make up most
+// writes, are XORed eight bytes at a time with unaligned loads and stores,
+// which these architectures support. Byte order does not matter, since each
+// byte is XORed with the byte at the same position.
+func xorIn(dst, src []byte) int {
+	n := min(len(dst), len(src))
+	if n >= xorInWordsMax {
+		return subtle.XORBytes(dst, dst, src)
+	}
+	d, s := unsafe.Pointer(unsafe.SliceData(dst)), unsafe.Pointer(unsafe.SliceData(src))
+	i := 0
+	for ; i+8 <= n; i += 8 {
+		*(*uint64)(unsafe.Add(d, i)) ^= *(*uint64)(unsafe.Add(s, i))
+	}
+	for ; i < n; i++ {
+		*(*byte)(unsafe.Add(d, i)) ^= *(*byte)(unsafe.Add(s, i))
+	}
+	return n
+}